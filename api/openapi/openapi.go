@@ -0,0 +1,11 @@
+// Package openapi embeds OpenAPI documents generated by buf from protobuf definitions, see make proto.
+package openapi
+
+import (
+	_ "embed"
+)
+
+// Invest is OpenAPI document of REST API of InvestService.
+//
+//go:embed invest/v1/invest.swagger.json
+var Invest []byte
@@ -0,0 +1,33 @@
+// Command dashboard writes Grafana dashboard of invest server metrics, see metrics.Dashboard.
+//
+// Usage:
+//
+//	go run ./cmd/dashboard -o deploy/grafana/invest.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"goinvest/internal/metrics"
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+
+	out := flag.String("o", "", "write dashboard to the file, stdout by default")
+	flag.Parse()
+
+	dashboard, err := metrics.Dashboard()
+	if err == nil {
+		if *out == "" {
+			_, err = os.Stdout.Write(dashboard)
+		} else {
+			err = ioutil.WriteFile(*out, dashboard, 0o644)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dashboard: %s\n", err)
+		os.Exit(1)
+	}
+}
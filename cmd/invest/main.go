@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -14,47 +15,86 @@ import (
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
-	"github.com/rs/cors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	gqlapi "goinvest/gen/gql/generated"
 	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/alert"
 	"goinvest/internal/config"
+	"goinvest/internal/export"
+	"goinvest/internal/gateway"
+	"goinvest/internal/health"
 	"goinvest/internal/invest"
+	"goinvest/internal/lifecycle"
+	"goinvest/internal/metrics"
 	"goinvest/internal/mysql"
+	"goinvest/internal/notify"
+	_ "goinvest/internal/providers/brokerreport"
+	_ "goinvest/internal/providers/fake"
+	_ "goinvest/internal/providers/manual"
+	_ "goinvest/internal/providers/tinkoff"
 	"goinvest/internal/redis"
+	"goinvest/internal/server"
 	"goinvest/internal/services/gqlservice"
 	"goinvest/internal/services/investservice"
 	"goinvest/internal/services/providerservice"
-	"golang.org/x/sync/errgroup"
+	"goinvest/internal/statement"
+	"goinvest/internal/telegram"
+	"goinvest/internal/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"net"
 	"net/http"
+	"net/http/pprof"
 	"os"
 	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 )
 
 type Config struct {
 	Server struct {
-		Host         string        `yaml:"host"`
-		Port         string        `yaml:"port"`
-		CloseTimeout time.Duration `yaml:"closeTimeout"`
-		DebugPort    string        `yaml:"debugPort"`
-		GqlPort      string        `yaml:"gqlPort"`
+		Host         string            `yaml:"host"`
+		Port         string            `yaml:"port" validate:"required"`
+		CloseTimeout time.Duration     `yaml:"closeTimeout" validate:"gte=0"` // bounds graceful shutdown, five seconds by default
+		DebugPort    string            `yaml:"debugPort" validate:"required"`
+		GqlPort      string            `yaml:"gqlPort" validate:"required"`
+		CORS         server.CORSConfig `yaml:"cors"`
+		HTTP         server.HTTPConfig `yaml:"http"`                  // timeouts of GraphQL, REST and gRPC-Web server
+		TLS          server.TLSConfig  `yaml:"tls"`                   // of gRPC and HTTP listeners, debug server is always plain HTTP
+		APIKeys      []string          `yaml:"apiKeys" secret:"true"` // bearer tokens required by gRPC, REST, gRPC-Web and GraphQL APIs when set
 	} `yaml:"server"`
 	Logger struct {
-		Level string `yaml:"level"`
+		Level string `yaml:"level"` // debug, info, warn, error, dpanic, panic or fatal, info by default
 	} `yaml:"logger"`
-	Database  mysql.DBConfig
-	Cache     invest.CacheCredentials
-	Providers invest.ProvidersConfig `yaml:"providers"`
+	Database      mysql.DBConfig          `yaml:"database"`
+	Migrations    mysql.MigrationsConfig  `yaml:"migrations"`
+	Cache         invest.CacheCredentials `yaml:"cache"`
+	Providers     invest.ProvidersConfig  `yaml:"providers"`
+	Statements    statement.Config        `yaml:"statements"`
+	Alerts        alert.Config            `yaml:"alerts"`
+	Notifications notify.Config           `yaml:"notifications"`
+	Bot           telegram.Config         `yaml:"bot"`
+	Tracing       tracing.Config          `yaml:"tracing"`
 }
 
+// envPrefix prefixes environment variables overriding config, e.g. INVEST_DATABASE_PASSWORD.
+const envPrefix = "INVEST"
+
+// providerCheckInterval is how often readiness check calls provider APIs.
+const providerCheckInterval = time.Minute
+
 func main() {
 
+	printConfig := flag.Bool("print-config", false, "print effective config with redacted secrets and exit")
+	flag.Parse()
+
 	const failed = 1
 	logger, atomicLevel, err := newLogger()
 	if err != nil {
@@ -62,252 +102,421 @@ func main() {
 		os.Exit(failed)
 	}
 
+	if *printConfig {
+		conf, _, err := newConfig(logger)
+		if err == nil {
+			err = config.Print(os.Stdout, conf)
+		}
+		if err != nil {
+			logger.Error("config printing problem", zap.Error(err))
+			_ = logger.Sync()
+			os.Exit(failed)
+		}
+		return
+	}
+
 	if err := run(logger, atomicLevel); err != nil {
 		logger.Error("invest web server start / shutdown problem", zap.Error(err))
+		_ = logger.Sync()
 		os.Exit(failed)
 	}
+	_ = logger.Sync()
 
 }
 
 // run performs the following things:
 // 1. Construct all dependencies, such as database, cache pools, external clients
 // 2. Wraps them to handy abstractions, such as services and repositories
-// 3. Pass dependencies to router and glue everything with http.Host
-// 4. Starts http.Host with above mentioned dependencies and manages graceful shutdown.
+// 3. Registers servers and background jobs as components of lifecycle manager
+// 4. Starts components in order and stops them in reverse order on SIGINT / SIGTERM or failure of any of them.
 func run(logger *zap.Logger, atomicLevel zap.AtomicLevel) error {
 
-	conf, err := newConfig(logger)
+	conf, watcher, err := newConfig(logger)
 	if err != nil {
 		return fmt.Errorf("config initialization problem: %w", err)
 	}
 
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	interrupt := make(chan os.Signal, 1)
-	signal.Notify(interrupt, os.Interrupt)
-	defer signal.Stop(interrupt)
-
-	g, ctx := errgroup.WithContext(ctx)
-
-	var (
-		grpcServer *grpc.Server
-		httpServer *http.Server
-	)
-
-	g.Go(func() error {
-
-		defer func() {
-			_ = logger.Sync()
-		}()
+	// signals abort startup as well, e.g. while database is unavailable
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-		// DEBUG < INFO < WARN < ERROR < DPanic < PANIC < FATAL
-		levels := map[string]zapcore.Level{
-			"debug":  zap.DebugLevel,
-			"info":   zap.InfoLevel,
-			"error":  zap.ErrorLevel,
-			"dpanic": zap.DPanicLevel,
-			"panic":  zap.PanicLevel,
-			"fatal":  zap.FatalLevel,
-		}
+	level, err := parseLevel(conf.Logger.Level)
+	if err != nil {
+		return fmt.Errorf("config initialization problem: %w", err)
+	}
+	atomicLevel.SetLevel(level)
 
-		atomicLevel.SetLevel(levels[strings.ToLower(conf.Logger.Level)])
-		db, closeDB, err := mysql.ConnectLoop(ctx, conf.Database, logger)
+	// spans are created even when tracing is disabled, global provider drops them
+	if conf.Tracing.Enabled {
+		exporter, err := tracing.NewExporter(conf.Tracing)
 		if err != nil {
-			return err
+			return fmt.Errorf("tracing exporter initialization problem: %w", err)
 		}
-		defer func() {
-			if err := closeDB(); err != nil {
-				logger.Error("problem occurred while closing database connection pool during server shutdown", zap.Error(err))
-			}
-		}()
-
-		//migrations := conf.Migrations
-		//if migrations.Enabled {
-		//	goose.SetLogger(zap.NewStdLog(logger.With(zap.String("service", "goose"))))
-		//	if err := goose.SetDialect(migrations.Dialect); err != nil {
-		//		return fmt.Errorf("goose problem while setting dialect: %w", err)
-		//	}
-		//	goose.SetTableName(migrations.Table)
-		//	goose.SetVerbose(migrations.Verbose)
-		//	if err := goose.Up(db, migrations.Directory); err != nil {
-		//		return fmt.Errorf("goose migration failed: %w", err)
-		//	}
-		//}
-
-		cache, closeCache, err := redis.ConnectLoop(ctx, conf.Cache, logger)
+		tracerProvider, err := tracing.NewProvider(conf.Tracing, exporter)
 		if err != nil {
 			return err
 		}
+		tracing.Setup(tracerProvider)
 		defer func() {
-			if err := closeCache(); err != nil {
-				logger.Error("problem occurred while closing cache connection pool during server shutdown", zap.Error(err))
+			// flushes spans of shutdown as well
+			ctx, cancel := context.WithTimeout(context.Background(), lifecycle.DefaultCloseTimeout)
+			defer cancel()
+			if err := tracerProvider.Shutdown(ctx); err != nil {
+				logger.Error("problem occurred while flushing spans during server shutdown", zap.Error(err))
 			}
 		}()
+	}
 
-		// let's define storage interfaces which incapsulates database operations
-		var (
-			mysqlStorage invest.Storage
-		)
-
-		mysqlStorage, err = mysql.NewStorage(db)
-		if err != nil {
-			return err
+	db, closeDB, err := mysql.ConnectLoop(ctx, conf.Database, logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := closeDB(); err != nil {
+			logger.Error("problem occurred while closing database connection pool during server shutdown", zap.Error(err))
 		}
+	}()
 
-		// let's define providers
-		providerService, err := providerservice.NewProviderService(&conf.Providers, mysqlStorage, cache, logger)
-		if err != nil {
-			return err
-		}
+	if err := mysql.Migrate(db, conf.Migrations, logger); err != nil {
+		return err
+	}
 
-		// let's define some services that contain business-logic here
-		investService, err := investservice.NewService(providerService, mysqlStorage, cache, logger)
-		if err != nil {
-			return err
+	cache, closeCache, err := redis.ConnectLoop(ctx, conf.Cache, logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := closeCache(); err != nil {
+			logger.Error("problem occurred while closing cache connection pool during server shutdown", zap.Error(err))
 		}
+	}()
+	ttlCache, err := redis.NewTTLCache(cache, conf.Cache.TTLs)
+	if err != nil {
+		return err
+	}
+	instrumentedCache := tracing.TraceCache(metrics.InstrumentCache(ttlCache))
 
-		router := chi.NewMux()
-		router.Use(cors.New(cors.Options{
-			AllowedOrigins:   []string{"http://localhost:8080"},
-			AllowCredentials: true,
-			Debug:            true,
-		}).Handler)
+	// let's define storage interfaces which incapsulates database operations
+	var (
+		mysqlStorage invest.Storage
+	)
 
-		resolver, err := gqlservice.NewResolver(providerService, mysqlStorage, cache, logger)
-		if err != nil {
-			return err
-		}
-		gqlServer := handler.New(gqlapi.NewExecutableSchema(gqlapi.Config{Resolvers: resolver}))
-		gqlServer.AddTransport(transport.POST{})
-		gqlServer.Use(extension.Introspection{})
-
-		// Graphql
-		router.Group(func(r chi.Router) {
-			r.Method("POST", "/graphql", gqlServer)
-		})
-		router.Get("/playground", playground.Handler("GraphQL playground", "/graphql"))
-
-		httpServer = &http.Server{
-			Addr:              net.JoinHostPort(conf.Server.Host, conf.Server.GqlPort),
-			ReadTimeout:       5 * time.Second,
-			WriteTimeout:      15 * time.Second,
-			ReadHeaderTimeout: 10 * time.Second,
-			IdleTimeout:       120 * time.Second,
-			ErrorLog:          zap.NewStdLog(logger.With(zap.String("service", "http"))),
-			Handler:           router,
-		}
-		logger.Info("starting gql server")
-		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			return fmt.Errorf("gql server error: %w", err)
-		}
+	mysqlStorage, err = mysql.NewStorage(db)
+	if err != nil {
+		return err
+	}
 
-		lis, err := net.Listen("tcp", net.JoinHostPort(conf.Server.Host, conf.Server.Port))
-		if err != nil {
-			return fmt.Errorf("problem while creating grpc listener: %w", err)
-		}
-		defer func() {
-			if err := lis.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
-				logger.Error("problem while trying to close listener", zap.Error(err))
-			}
-		}()
+	// let's define providers
+	providerService, err := providerservice.NewProviderService(&conf.Providers, mysqlStorage, instrumentedCache, logger)
+	if err != nil {
+		return err
+	}
 
-		var opts []grpc.ServerOption
-		opts = append(opts, grpcmw.WithUnaryServerChain(
-			grpc_recovery.UnaryServerInterceptor(),
-			grpc_ctxtags.UnaryServerInterceptor(),
-			grpc_zap.UnaryServerInterceptor(logger),
-			grpc_prometheus.UnaryServerInterceptor,
-			investService.ErrorUnaryInterceptor,
-			investService.ValidationUnaryInterceptor,
-		))
+	// let's define some services that contain business-logic here
+	investService, err := investservice.NewService(providerService, mysqlStorage, instrumentedCache, logger)
+	if err != nil {
+		return err
+	}
 
-		grpcServer = grpc.NewServer(opts...)
-		pb.RegisterInvestServiceServer(grpcServer, investService)
-		grpc_prometheus.EnableHandlingTimeHistogram()
-		grpc_prometheus.Register(grpcServer)
-		reflection.Register(grpcServer)
+	// components start in order of addition and stop in reverse order:
+	// debug server is the first to start and the last to stop, background jobs stop first
+	lc, err := lifecycle.New(logger)
+	if err != nil {
+		return err
+	}
 
-		logger.Info("starting grpc server", zap.String("port", conf.Server.Port))
+	// =========================================================================
+	// Health checks
+	checker, err := health.NewChecker(0, logger)
+	if err != nil {
+		return err
+	}
+	checker.Add("server", func(context.Context) error {
+		if !lc.Ready() {
+			return errors.New("server is starting or shutting down")
+		}
+		return nil
+	})
+	checker.Add("mysql", db.PingContext)
+	if pinger, ok := cache.(interface{ Ping(context.Context) error }); ok {
+		checker.Add("cache", pinger.Ping)
+	}
+	// providers are called bypassing rate limits and metrics, not often as probes do,
+	// and broker outage degrades the server without taking it out of service
+	for id, provider := range providerService.Providers() {
+		provider := invest.UnwrapProvider(provider)
+		checker.AddOptional("provider "+id.String(), health.Cached(func(ctx context.Context) error {
+			_, err := provider.Accounts(ctx, &pb.AccountsRequest{})
+			return err
+		}, providerCheckInterval))
+	}
 
-		return grpcServer.Serve(lis)
+	// =========================================================================
+	// Debug Service
+	debugRouter := chi.NewMux()
+	debugRouter.HandleFunc("/debug/pprof/*", pprof.Index)
+	debugRouter.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	debugRouter.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	debugRouter.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	debugRouter.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	debugRouter.Handle("/metrics", promhttp.Handler())
+	debugRouter.Handle("/health/live", checker.LiveHandler())
+	debugRouter.Handle("/health/ready", checker.ReadyHandler())
+
+	lc.Add(lifecycle.HTTPServer("debug server", &http.Server{
+		Addr:              net.JoinHostPort(conf.Server.Host, conf.Server.DebugPort),
+		ReadTimeout:       5 * time.Second,
+		WriteTimeout:      time.Minute, // CPU profile is collected for thirty seconds by default
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		ErrorLog:          zap.NewStdLog(logger.With(zap.String("service", "debug"))),
+		Handler:           debugRouter,
+	}))
 
-	})
+	// =========================================================================
+	// TLS of gRPC and HTTP listeners
+	tlsConf, err := server.LoadTLS(conf.Server.TLS, conf.Server.Host)
+	if err != nil {
+		return fmt.Errorf("tls initialization problem: %w", err)
+	}
+	if tlsConf != nil && conf.Server.TLS.SelfSigned {
+		logger.Warn("listeners use self-signed certificate, it is suitable for development only")
+	}
+
+	apiKeys, err := server.NewAPIKeys(conf.Server.APIKeys)
+	if err != nil {
+		return fmt.Errorf("api keys initialization problem: %w", err)
+	}
+	if apiKeys.Enabled() && tlsConf == nil {
+		logger.Warn("api keys are sent in clear text, enable TLS of listeners")
+	}
 
 	// =========================================================================
-	// Start Debug Service
-	//
-	// /debug/pprof - Added to the default mux by importing the net/http/pprof package.
-	g.Go(func() error {
-
-		router := chi.NewMux()
-		//router.Route("/pprof", metrics.PprofRouter)
-		//router.Handle("/metrics", metrics.PrometheusHandler())
-		//router.HandleFunc("/health/ready", health.ReadinessHandler)
-		//router.HandleFunc("/health/live", health.LiveNessHandler)
-
-		httpServer = &http.Server{
-			Addr:              net.JoinHostPort(conf.Server.Host, conf.Server.DebugPort),
-			ReadTimeout:       5 * time.Second,
-			WriteTimeout:      15 * time.Second,
-			ReadHeaderTimeout: 10 * time.Second,
-			IdleTimeout:       120 * time.Second,
-			ErrorLog:          zap.NewStdLog(logger.With(zap.String("service", "http"))),
-			Handler:           router,
-		}
-		logger.Info("starting http server")
-		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			return fmt.Errorf("http server error: %w", err)
-		}
+	// gRPC Service
+	var opts []grpc.ServerOption
+	if tlsConf != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConf)))
+	}
+	opts = append(opts, grpcmw.WithUnaryServerChain(
+		grpc_recovery.UnaryServerInterceptor(),
+		grpc_ctxtags.UnaryServerInterceptor(),
+		tracing.UnaryServerInterceptor,
+		grpc_zap.UnaryServerInterceptor(logger),
+		grpc_prometheus.UnaryServerInterceptor,
+		apiKeys.UnaryServerInterceptor,
+		investService.ErrorUnaryInterceptor,
+		investService.ValidationUnaryInterceptor,
+	))
+	opts = append(opts, grpc.StreamInterceptor(apiKeys.StreamServerInterceptor))
+
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterInvestServiceServer(grpcServer, investService)
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	grpc_prometheus.EnableHandlingTimeHistogram()
+	grpc_prometheus.Register(grpcServer)
+	reflection.Register(grpcServer)
+
+	grpcAddr := net.JoinHostPort(conf.Server.Host, conf.Server.Port)
+	lc.Add(lifecycle.GRPCServer("grpc server", grpcAddr, grpcServer))
 
-		logger.Info("server shutdown gracefully")
-		return nil
+	// =========================================================================
+	// GraphQL Service
+	router := chi.NewMux()
+	router.Use(tracing.Middleware)
+	corsConf := conf.Server.CORS
+	corsConf.AllowedHeaders = append(append([]string{"Accept", "Authorization", "X-Requested-With"}, corsConf.AllowedHeaders...), gateway.GRPCWebRequestHeaders...)
+	corsConf.ExposedHeaders = append(append([]string{}, corsConf.ExposedHeaders...), gateway.GRPCWebResponseHeaders...)
+	corsMiddleware, err := server.CORS(corsConf)
+	if err != nil {
+		return fmt.Errorf("cors initialization problem: %w", err)
+	}
+	router.Use(corsMiddleware)
 
+	resolver, err := gqlservice.NewResolver(providerService, mysqlStorage, instrumentedCache, logger)
+	if err != nil {
+		return err
+	}
+	gqlServer := handler.New(gqlapi.NewExecutableSchema(gqlapi.Config{Resolvers: resolver}))
+	gqlServer.AddTransport(transport.POST{})
+	gqlServer.Use(extension.Introspection{})
+	gqlServer.Use(tracing.GraphQL{})
+
+	// Graphql
+	router.Group(func(r chi.Router) {
+		r.Use(apiKeys.Middleware)
+		r.Method("POST", "/graphql", gqlServer)
 	})
+	router.Get("/playground", playground.Handler("GraphQL playground", "/graphql"))
 
-	select {
-	case <-interrupt:
-		break
-	case <-ctx.Done():
-		break
+	// REST API calls gRPC server, so requests pass its interceptors, connection is established lazily
+	gatewayCreds := insecure.NewCredentials()
+	if tlsConf != nil {
+		gatewayCreds = credentials.NewTLS(server.ClientTLS(tlsConf))
 	}
+	gatewayConn, err := grpc.DialContext(ctx, dialAddr(grpcAddr),
+		grpc.WithTransportCredentials(gatewayCreds),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor),
+	)
+	if err != nil {
+		return fmt.Errorf("problem while creating gateway connection: %w", err)
+	}
+	defer gatewayConn.Close()
+	gatewayHandler, err := gateway.Handler(ctx, gatewayConn)
+	if err != nil {
+		return err
+	}
+	router.Handle("/v1/*", gatewayHandler)
+	router.Get("/openapi.json", gateway.OpenAPIHandler().ServeHTTP)
 
-	logger.Info("shutting down...")
+	// gRPC-Web for browser clients
+	router.Handle("/"+pb.InvestService_ServiceDesc.ServiceName+"/*", gateway.GRPCWebHandler(grpcServer))
 
-	cancel()
+	// Report downloads
+	router.Mount("/export", apiKeys.Middleware(export.Handler(providerService.Router(), logger)))
 
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer shutdownCancel()
+	httpServer, err := server.NewHTTPServer(net.JoinHostPort(conf.Server.Host, conf.Server.GqlPort),
+		conf.Server.HTTP, tlsConf, router, logger.With(zap.String("service", "http")))
+	if err != nil {
+		return err
+	}
+	lc.Add(lifecycle.HTTPServer("gql server", httpServer))
 
-	if httpServer != nil {
-		if err = httpServer.Shutdown(shutdownCtx); err != nil {
-			logger.Error("problem while shutting down http server", zap.Error(err))
+	// =========================================================================
+	// Background jobs
+	var bot *telegram.Bot
+	if conf.Bot.Enabled {
+		if bot, err = telegram.NewBot(conf.Bot, investService, mysqlStorage, logger); err != nil {
+			return err
 		}
+		lc.Add(lifecycle.Job("telegram bot", bot.Run))
 	}
-
-	if grpcServer != nil {
-		grpcServer.GracefulStop()
+	var notifier invest.Notifier
+	if conf.Notifications.Enabled {
+		n, err := notify.NewNotifier(conf.Notifications, mysqlStorage, logger)
+		if err != nil {
+			return err
+		}
+		// telegram channels are delivered by the bot users linked their chats with
+		if bot != nil {
+			n.Register(notify.ChannelTelegram, bot.Sender(n.Timeout()))
+		}
+		notifier = n
+	}
+	if conf.Statements.Enabled {
+		scheduler, err := statement.NewScheduler(conf.Statements, providerService.Router(), mysqlStorage, notifier, logger)
+		if err != nil {
+			return err
+		}
+		lc.Add(lifecycle.Job("statement scheduler", scheduler.Run))
+	}
+	var evaluator *alert.Evaluator
+	if conf.Alerts.Enabled {
+		if evaluator, err = alert.NewEvaluator(conf.Alerts, providerService.Router(), mysqlStorage, notifier, logger); err != nil {
+			return err
+		}
+		lc.Add(lifecycle.Job("alert evaluator", evaluator.Run))
 	}
 
-	return g.Wait()
+	// settings below are applied without restart, connections and background jobs keep running
+	watcher.OnChange(func(e config.Event) {
+		next := e.New.(*Config)
+		if e.Has("logger.level") {
+			if level, err := parseLevel(next.Logger.Level); err != nil {
+				logger.Error("problem while applying logger level", zap.Error(err))
+			} else {
+				atomicLevel.SetLevel(level)
+			}
+		}
+		if e.Has("providers") {
+			providerService.UpdateRateLimits(&next.Providers)
+		}
+		if e.Has("cache.ttls") {
+			if err := ttlCache.SetTTLs(next.Cache.TTLs); err != nil {
+				logger.Error("problem while applying cache ttls", zap.Error(err))
+			}
+		}
+		if evaluator != nil && (e.Has("alerts.interval") || e.Has("alerts.currencyRates")) {
+			evaluator.Reconfigure(next.Alerts)
+		}
 
+		var restart []string
+		for _, key := range e.Changed {
+			if !hotReloaded(key, evaluator != nil) {
+				restart = append(restart, key)
+			}
+		}
+		if len(restart) > 0 {
+			logger.Warn("config changes take effect after restart", zap.Strings("keys", restart))
+		}
+	})
+	lc.Add(lifecycle.Job("config watcher", watcher.Run))
+
+	// the first job to stop, so clients see not serving status before gRPC server stops
+	lc.Add(lifecycle.Job("grpc health", func(ctx context.Context) error {
+		return checker.UpdateGRPC(ctx, healthServer, 0, pb.InvestService_ServiceDesc.ServiceName)
+	}))
+
+	return lc.Run(ctx, conf.Server.CloseTimeout)
 }
 
 // newConfig is a constructor-like function which
-// returns config object filled from YAML file specified in arguments
-// if file was not specified it looks for env variable ${GEO_FACADE_CONFIG}
-// if neither argument nor env was specified it tries to look for hardcoded path for conf
+// returns config object filled from config/dev.yaml or config/main.yaml, Consul and Vault,
+// every field is overridden by environment variable of config.EnvVar with envPrefix, e.g. INVEST_SERVER_PORT,
 // returns error in case of file open error or if config does not comply with invariant.
-func newConfig(log *zap.Logger) (*Config, error) {
+// Watcher reloads config on change of file or Consul key.
+func newConfig(log *zap.Logger) (*Config, *config.Watcher, error) {
 	const configDir = "config"
 
 	cfg := &Config{}
-	err := config.Parse(cfg, config.Options{Dir: configDir, Type: "yaml"}, log)
+	watcher, err := config.NewWatcher(cfg, config.Options{Dir: configDir, Type: "yaml", ReplaceFromEnvVars: true, EnvVarsPrefix: envPrefix}, log)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	return cfg, watcher, nil
+}
+
+// hotReloaded reports whether change of config key is applied without restart.
+// Providers are not restarted, only their rate limits are applied.
+func hotReloaded(key string, alertsEnabled bool) bool {
+	switch {
+	case key == "logger.level", key == "cache.ttls", key == "providers.list", key == "providers.tinkoff.rps":
+		return true
+	case key == "alerts.interval", key == "alerts.currencyRates":
+		return alertsEnabled
+	default:
+		return false
+	}
+}
+
+// parseLevel returns logger level by its case-insensitive name, info level is the default one.
+func parseLevel(name string) (zapcore.Level, error) {
+
+	// DEBUG < INFO < WARN < ERROR < DPanic < PANIC < FATAL
+	levels := map[string]zapcore.Level{
+		"debug":  zap.DebugLevel,
+		"":       zap.InfoLevel,
+		"info":   zap.InfoLevel,
+		"warn":   zap.WarnLevel,
+		"error":  zap.ErrorLevel,
+		"dpanic": zap.DPanicLevel,
+		"panic":  zap.PanicLevel,
+		"fatal":  zap.FatalLevel,
+	}
+	level, found := levels[strings.ToLower(name)]
+	if !found {
+		return 0, fmt.Errorf("unknown logger level %q", name)
+	}
+	return level, nil
+}
+
+// dialAddr returns address to dial a listener of the service, listeners on all interfaces are dialed on localhost.
+func dialAddr(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || (host != "" && host != "0.0.0.0" && host != "::") {
+		return addr
 	}
-	return cfg, nil
+	return net.JoinHostPort("localhost", port)
 }
 
 func newLogger() (*zap.Logger, zap.AtomicLevel, error) {
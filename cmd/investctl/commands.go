@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/export"
+	"goinvest/internal/invest"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+type command struct {
+	usage string
+	run   func(ctx context.Context, client pb.InvestServiceClient, fs *flag.FlagSet, args []string, out *output) error
+}
+
+var commands = map[string]command{
+	"accounts":   {usage: "list accounts of all providers", run: accounts},
+	"portfolio":  {usage: "show positions and cash of accounts", run: portfolio},
+	"operations": {usage: "list operations of accounts", run: operations},
+	"export":     {usage: "download report as " + strings.Join(export.Formats(), ", "), run: exportReport},
+}
+
+func accounts(ctx context.Context, client pb.InvestServiceClient, fs *flag.FlagSet, args []string, out *output) error {
+
+	provider := fs.String("provider", "", "show accounts of the provider only")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	list, err := selectAccounts(ctx, client, *provider, "")
+	if err != nil {
+		return err
+	}
+	rows := make([][]interface{}, 0, len(list))
+	for _, a := range list {
+		rows = append(rows, []interface{}{a.Provider, a.AccountId, accountType(a.AccountType)})
+	}
+	return out.print([]string{"provider", "account", "type"}, rows)
+}
+
+func portfolio(ctx context.Context, client pb.InvestServiceClient, fs *flag.FlagSet, args []string, out *output) error {
+
+	provider := fs.String("provider", "", "show accounts of the provider only")
+	accountID := fs.String("account", "", "show the account only, all accounts by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	list, err := selectAccounts(ctx, client, *provider, *accountID)
+	if err != nil {
+		return err
+	}
+	var rows [][]interface{}
+	for _, a := range list {
+		resp, err := client.GetPortfolio(ctx, &pb.PortfolioRequest{Account: a})
+		if err != nil {
+			return fmt.Errorf("get portfolio of account %s: %w", a.AccountId, err)
+		}
+		for _, p := range resp.Positions {
+			// currency positions are reported as cash
+			if p.InstrumentType == invest.InstrumentTypeCurrency {
+				continue
+			}
+			value, currency := invest.PositionValue(p)
+			price, _ := invest.PositionPrice(p)
+			rows = append(rows, []interface{}{
+				a.AccountId, p.Ticker, p.Figi, p.InstrumentType, p.Balance, price, value, p.ExpectedYield.GetValue(), currency,
+			})
+		}
+		for _, c := range resp.Currencies {
+			rows = append(rows, []interface{}{a.AccountId, c.Currency, "", "Cash", c.Balance, 1.0, c.Balance, 0.0, c.Currency})
+		}
+	}
+	return out.print([]string{"account", "ticker", "figi", "type", "balance", "price", "value", "yield", "currency"}, rows)
+}
+
+func operations(ctx context.Context, client pb.InvestServiceClient, fs *flag.FlagSet, args []string, out *output) error {
+
+	provider := fs.String("provider", "", "show accounts of the provider only")
+	accountID := fs.String("account", "", "show the account only, all accounts by default")
+	figi := fs.String("figi", "", "show operations with the instrument only")
+	from := fs.String("from", "", "start of period, RFC 3339 time or YYYY-MM-DD date, 30 days ago by default")
+	to := fs.String("to", "", "end of period, RFC 3339 time or YYYY-MM-DD date, now by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	fromTime, err := parseTime(*from, now.AddDate(0, 0, -30))
+	if err != nil {
+		return err
+	}
+	toTime, err := parseTime(*to, now)
+	if err != nil {
+		return err
+	}
+
+	list, err := selectAccounts(ctx, client, *provider, *accountID)
+	if err != nil {
+		return err
+	}
+	var rows [][]interface{}
+	for _, a := range list {
+		resp, err := client.GetOperations(ctx, &pb.OperationsRequest{
+			Account: a,
+			From:    fromTime,
+			To:      toTime,
+			Figi:    *figi,
+		})
+		if err != nil {
+			return fmt.Errorf("get operations of account %s: %w", a.AccountId, err)
+		}
+		for _, o := range resp.Operations {
+			rows = append(rows, []interface{}{
+				a.AccountId, o.Date, operationType(o.OperationType), o.Figi, o.Quantity, o.Price, o.Payment,
+				o.Commission.GetValue(), o.Currency, o.Id,
+			})
+		}
+	}
+	return out.print([]string{
+		"account", "date", "type", "figi", "quantity", "price", "payment", "commission", "currency", "id",
+	}, rows)
+}
+
+func exportReport(ctx context.Context, client pb.InvestServiceClient, fs *flag.FlagSet, args []string, out *output) error {
+
+	var (
+		req      pb.ExportReportRequest
+		provider = fs.String("provider", "", "export accounts of the provider, required with -account")
+		account  = fs.String("account", "", "export the account only, all accounts of provider by default")
+		columns  = fs.String("columns", "", "comma separated columns, all by default")
+		from     = fs.String("from", "", "start of period, RFC 3339 time or YYYY-MM-DD date")
+		to       = fs.String("to", "", "end of period, RFC 3339 time or YYYY-MM-DD date")
+		file     = fs.String("file", "", "write report to the file, stdout by default")
+	)
+	fs.StringVar(&req.Report, "report", export.ReportPositions, "report: "+strings.Join(export.Reports(), ", "))
+	fs.StringVar(&req.Format, "format", "csv", "format: "+strings.Join(export.Formats(), ", "))
+	fs.StringVar(&req.Locale, "locale", "", "locale of numbers and dates")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *provider == "" {
+		return fmt.Errorf("-provider is required")
+	}
+	req.Account = &pb.Account{Provider: *provider, AccountId: *account}
+	if *columns != "" {
+		req.Columns = strings.Split(*columns, ",")
+	}
+	var err error
+	if req.From, err = parseTime(*from, time.Time{}); err != nil {
+		return err
+	}
+	if req.To, err = parseTime(*to, time.Time{}); err != nil {
+		return err
+	}
+
+	resp, err := client.ExportReport(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("export report: %w", err)
+	}
+	if *file != "" {
+		return ioutil.WriteFile(*file, resp.Content, 0o644)
+	}
+	_, err = out.w.Write(resp.Content)
+	return err
+}
+
+// selectAccounts returns accounts filtered by provider and id, empty filters match any account.
+func selectAccounts(ctx context.Context, client pb.InvestServiceClient, provider, accountID string) ([]*pb.Account, error) {
+
+	resp, err := client.GetAccounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get accounts: %w", err)
+	}
+	var accounts []*pb.Account
+	for _, a := range resp.Accounts {
+		if (provider == "" || a.Provider == provider) && (accountID == "" || a.AccountId == accountID) {
+			accounts = append(accounts, a)
+		}
+	}
+	if accountID != "" && len(accounts) == 0 {
+		return nil, fmt.Errorf("account %s is not found", accountID)
+	}
+	return accounts, nil
+}
+
+// parseTime converts flag value to RFC 3339 time, empty value is replaced with fallback unless it is zero.
+func parseTime(value string, fallback time.Time) (string, error) {
+
+	if value == "" {
+		if fallback.IsZero() {
+			return "", nil
+		}
+		return fallback.Format(time.RFC3339), nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t.Format(time.RFC3339), nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return "", fmt.Errorf("bad time %q, use RFC 3339 time or YYYY-MM-DD date", value)
+	}
+	return value, nil
+}
+
+func accountType(t pb.AccountType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "TYPE_"))
+}
+
+func operationType(t pb.OperationType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "OPERATION_TYPE_"))
+}
@@ -0,0 +1,172 @@
+// Command investctl queries InvestService over gRPC.
+//
+// Usage:
+//
+//	investctl [flags] <command> [command flags]
+//
+// Address and API key default to INVEST_ADDR and INVEST_API_KEY environment variables. The key must match
+// one of server.apiKeys of the server and is sent over TLS only.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const defaultAddr = "localhost:9090"
+
+// options are global flags shared by commands.
+type options struct {
+	addr       string
+	apiKey     string
+	tls        bool
+	caFile     string
+	serverName string
+	insecure   bool
+	output     string
+	timeout    time.Duration
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "investctl: %s\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+
+	var opts options
+	fs := flag.NewFlagSet("investctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.addr, "addr", envOr("INVEST_ADDR", defaultAddr), "InvestService gRPC address")
+	fs.StringVar(&opts.apiKey, "api-key", os.Getenv("INVEST_API_KEY"), "API key sent as bearer token, requires TLS")
+	fs.BoolVar(&opts.tls, "tls", false, "connect over TLS")
+	fs.StringVar(&opts.caFile, "ca", "", "PEM file of CA certificates used to verify server, implies -tls")
+	fs.StringVar(&opts.serverName, "server-name", "", "server name expected in certificate, host of -addr by default")
+	fs.BoolVar(&opts.insecure, "insecure", false, "skip verification of server certificate")
+	fs.StringVar(&opts.output, "o", formatTable, "output format: "+strings.Join(outputFormats, ", "))
+	fs.DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout of a command")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: investctl [flags] <command> [command flags]\n\nCommands:\n")
+		names := make([]string, 0, len(commands))
+		for name := range commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(stderr, "  %-12s %s\n", name, commands[name].usage)
+		}
+		fmt.Fprintf(stderr, "\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	cmd, found := commands[fs.Arg(0)]
+	if !found {
+		fs.Usage()
+		return fmt.Errorf("unknown command %q", fs.Arg(0))
+	}
+	out, err := newOutput(stdout, opts.output)
+	if err != nil {
+		return err
+	}
+
+	conn, err := dial(opts)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	return cmd.run(ctx, pb.NewInvestServiceClient(conn), commandFlags(fs.Arg(0), stderr), fs.Args()[1:], out)
+}
+
+func dial(opts options) (*grpc.ClientConn, error) {
+
+	secure := opts.tls || opts.caFile != "" || opts.insecure
+	if opts.apiKey != "" && !secure {
+		return nil, errors.New("api key is sent over TLS only, add -tls or -ca flag")
+	}
+
+	creds := insecure.NewCredentials()
+	if secure {
+		conf := &tls.Config{
+			ServerName: opts.serverName,
+			// verification is explicitly disabled by user
+			InsecureSkipVerify: opts.insecure,
+			MinVersion:         tls.VersionTLS12,
+		}
+		if opts.caFile != "" {
+			pem, err := ioutil.ReadFile(opts.caFile)
+			if err != nil {
+				return nil, fmt.Errorf("read CA file: %w", err)
+			}
+			conf.RootCAs = x509.NewCertPool()
+			if !conf.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates found in %s", opts.caFile)
+			}
+		}
+		creds = credentials.NewTLS(conf)
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if opts.apiKey != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(apiKey(opts.apiKey)))
+	}
+
+	conn, err := grpc.Dial(opts.addr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", opts.addr, err)
+	}
+	return conn, nil
+}
+
+// apiKey authorizes calls with bearer token.
+type apiKey string
+
+func (k apiKey) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(k)}, nil
+}
+
+// RequireTransportSecurity forbids sending the key over plaintext connections.
+func (k apiKey) RequireTransportSecurity() bool {
+	return true
+}
+
+func commandFlags(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("investctl "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
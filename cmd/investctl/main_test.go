@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testServer struct {
+	pb.UnimplementedInvestServiceServer
+	authorization []string
+	operations    *pb.OperationsRequest
+	export        *pb.ExportReportRequest
+}
+
+func (s *testServer) GetAccounts(ctx context.Context, _ *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.authorization = md.Get("authorization")
+	return &pb.AccountsResponse{Accounts: []*pb.Account{
+		{Provider: "tinkoff", AccountId: "2000", AccountType: pb.AccountType_TYPE_BROKER},
+		{Provider: "manual", AccountId: "cash", AccountType: pb.AccountType_TYPE_UNSPECIFIED},
+	}}, nil
+}
+
+func (s *testServer) GetPortfolio(_ context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	return &pb.PortfolioResponse{
+		Positions: []*pb.Position{{
+			Figi:                 "BBG000B9XRY4",
+			Ticker:               "AAPL",
+			InstrumentType:       "Stock",
+			Balance:              10,
+			AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 100},
+			ExpectedYield:        &pb.Yield{Currency: "USD", Value: 50},
+		}},
+		Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 1000.1}},
+	}, nil
+}
+
+func (s *testServer) GetOperations(_ context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+	s.operations = req
+	return &pb.OperationsResponse{Operations: []*pb.Operation{{
+		Id:            "1",
+		OperationType: pb.OperationType_OPERATION_TYPE_BUY,
+		Figi:          "BBG000B9XRY4",
+		Date:          "2021-03-01T10:00:00Z",
+		Quantity:      2,
+		Price:         100.5,
+		Payment:       -201,
+		Currency:      "USD",
+		Commission:    &pb.Yield{Currency: "USD", Value: -0.6},
+	}}}, nil
+}
+
+func (s *testServer) ExportReport(_ context.Context, req *pb.ExportReportRequest) (*pb.ExportReportResponse, error) {
+	s.export = req
+	return &pb.ExportReportResponse{Filename: "positions.csv", ContentType: "text/csv", Content: []byte("figi\n")}, nil
+}
+
+func startServer(t *testing.T, opts ...grpc.ServerOption) (*testServer, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &testServer{}
+	s := grpc.NewServer(opts...)
+	pb.RegisterInvestServiceServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+	return srv, lis.Addr().String()
+}
+
+func TestCommands(t *testing.T) {
+
+	srv, addr := startServer(t)
+	file := filepath.Join(t.TempDir(), "report.csv")
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{
+			args: []string{"accounts"},
+			want: "PROVIDER  ACCOUNT  TYPE\ntinkoff   2000     broker\nmanual    cash     unspecified\n",
+		},
+		{
+			args: []string{"-o", "csv", "accounts", "-provider", "manual"},
+			want: "provider,account,type\nmanual,cash,unspecified\n",
+		},
+		{
+			args: []string{"-o", "csv", "portfolio", "-account", "2000"},
+			want: "account,ticker,figi,type,balance,price,value,yield,currency\n" +
+				"2000,AAPL,BBG000B9XRY4,Stock,10,105,1050,50,USD\n" +
+				"2000,RUB,,Cash,1000.1,1,1000.1,0,RUB\n",
+		},
+		{
+			args: []string{"-o", "csv", "operations", "-account", "2000", "-from", "2021-03-01", "-to", "2021-04-01T00:00:00Z"},
+			want: "account,date,type,figi,quantity,price,payment,commission,currency,id\n" +
+				"2000,2021-03-01T10:00:00Z,buy,BBG000B9XRY4,2,100.5,-201,-0.6,USD,1\n",
+		},
+		{
+			args: []string{"export", "-provider", "tinkoff", "-columns", "figi,ticker", "-file", file},
+		},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		if err := run(context.Background(), append([]string{"-addr", addr}, tt.args...), &stdout, &stderr); err != nil {
+			t.Fatalf("%v: %s", tt.args, err)
+		}
+		if stdout.String() != tt.want {
+			t.Errorf("%v output:\n%s\nwant:\n%s", tt.args, stdout.String(), tt.want)
+		}
+	}
+
+	if len(srv.authorization) != 0 {
+		t.Errorf("authorization without api key = %v", srv.authorization)
+	}
+	if srv.operations.From != "2021-03-01T00:00:00Z" || srv.operations.To != "2021-04-01T00:00:00Z" {
+		t.Errorf("operations period = %s - %s", srv.operations.From, srv.operations.To)
+	}
+	if srv.export.Report != "positions" || srv.export.Format != "csv" || strings.Join(srv.export.Columns, ",") != "figi,ticker" {
+		t.Errorf("export request = %v", srv.export)
+	}
+	if content, err := ioutil.ReadFile(file); err != nil || string(content) != "figi\n" {
+		t.Errorf("exported file = %q, %v", content, err)
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+
+	tlsConf, err := server.LoadTLS(server.TLSConfig{Enabled: true, SelfSigned: true}, "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, addr := startServer(t, grpc.Creds(credentials.NewTLS(tlsConf)))
+
+	// key is not sent in clear text
+	args := []string{"-addr", addr, "-api-key", "secret", "-o", "json", "accounts"}
+	if err := run(context.Background(), args, ioutil.Discard, ioutil.Discard); err == nil || !strings.Contains(err.Error(), "TLS") {
+		t.Errorf("api key without TLS: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	if err := run(context.Background(), append([]string{"-insecure"}, args...), &stdout, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if len(srv.authorization) != 1 || srv.authorization[0] != "Bearer secret" {
+		t.Errorf("authorization = %v", srv.authorization)
+	}
+
+	var accounts []map[string]interface{}
+	if err := json.Unmarshal(stdout.Bytes(), &accounts); err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 2 || accounts[0]["account"] != "2000" || accounts[0]["provider"] != "tinkoff" {
+		t.Errorf("accounts = %v", accounts)
+	}
+}
+
+func TestErrors(t *testing.T) {
+
+	_, addr := startServer(t)
+	tests := map[string][]string{
+		"unknown command":  {"balance"},
+		"unknown format":   {"-o", "xml", "accounts"},
+		"unknown account":  {"portfolio", "-account", "3000"},
+		"bad time":         {"operations", "-from", "yesterday"},
+		"missing provider": {"export"},
+	}
+	for name, args := range tests {
+		if err := run(context.Background(), append([]string{"-addr", addr}, args...), ioutil.Discard, ioutil.Discard); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
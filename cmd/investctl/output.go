@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+var outputFormats = []string{formatTable, formatJSON, formatCSV}
+
+// output renders command results as aligned table, JSON array of objects or CSV.
+type output struct {
+	w      io.Writer
+	format string
+}
+
+func newOutput(w io.Writer, format string) (*output, error) {
+	for _, f := range outputFormats {
+		if f == format {
+			return &output{w: w, format: format}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown output format %q, supported formats: %s", format, strings.Join(outputFormats, ", "))
+}
+
+// print renders rows, columns are snake case names used as JSON keys and CSV header.
+// Cells are strings or numbers, numbers stay numbers in JSON.
+func (o *output) print(columns []string, rows [][]interface{}) error {
+
+	switch o.format {
+	case formatJSON:
+		objects := make([]map[string]interface{}, 0, len(rows))
+		for _, row := range rows {
+			object := make(map[string]interface{}, len(columns))
+			for i, c := range columns {
+				object[c] = row[i]
+			}
+			objects = append(objects, object)
+		}
+		enc := json.NewEncoder(o.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(objects)
+
+	case formatCSV:
+		w := csv.NewWriter(o.w)
+		if err := w.Write(columns); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := w.Write(cells(row)); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+
+	default:
+		w := tabwriter.NewWriter(o.w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, strings.ToUpper(strings.Join(columns, "\t")))
+		for _, row := range rows {
+			fmt.Fprintln(w, strings.Join(cells(row), "\t"))
+		}
+		return w.Flush()
+	}
+}
+
+func cells(row []interface{}) []string {
+	cells := make([]string, len(row))
+	for i, v := range row {
+		switch v := v.(type) {
+		case float64:
+			// rounding hides float noise of money values
+			cells[i] = strconv.FormatFloat(math.Round(v*1e6)/1e6, 'f', -1, 64)
+		default:
+			cells[i] = fmt.Sprint(v)
+		}
+	}
+	return cells
+}
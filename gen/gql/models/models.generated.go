@@ -11,23 +11,307 @@ import (
 type Account struct {
 	AccountID   *string      `json:"accountId"`
 	AccountType *AccountType `json:"accountType"`
+	Provider    *string      `json:"provider"`
 }
 
 type AccountInput struct {
 	AccountID   *string      `json:"accountId"`
 	AccountType *AccountType `json:"accountType"`
+	Provider    *string      `json:"provider"`
 }
 
 type AccountsResponse struct {
 	Accounts []*Account `json:"accounts"`
 }
 
+type AlertEvent struct {
+	ID          *int       `json:"id"`
+	RuleID      *int       `json:"ruleId"`
+	Type        *AlertType `json:"type"`
+	Account     *Account   `json:"account"`
+	Figi        *string    `json:"figi"`
+	Threshold   *float64   `json:"threshold"`
+	Value       *float64   `json:"value"`
+	Message     *string    `json:"message"`
+	TriggeredAt *string    `json:"triggeredAt"`
+}
+
+type AlertEventsRequestInput struct {
+	RuleID  *int          `json:"ruleId"`
+	Account *AccountInput `json:"account"`
+	From    *string       `json:"from"`
+	Limit   *int          `json:"limit"`
+}
+
+type AlertEventsResponse struct {
+	Events []*AlertEvent `json:"events"`
+}
+
+type AlertRule struct {
+	ID          *int       `json:"id"`
+	Type        *AlertType `json:"type"`
+	Name        *string    `json:"name"`
+	Account     *Account   `json:"account"`
+	Figi        *string    `json:"figi"`
+	Threshold   *float64   `json:"threshold"`
+	Enabled     *bool      `json:"enabled"`
+	CreatedAt   *string    `json:"createdAt"`
+	TriggeredAt *string    `json:"triggeredAt"`
+}
+
+type AlertRuleInput struct {
+	ID        *int          `json:"id"`
+	Type      *AlertType    `json:"type"`
+	Name      *string       `json:"name"`
+	Account   *AccountInput `json:"account"`
+	Figi      *string       `json:"figi"`
+	Threshold *float64      `json:"threshold"`
+	Enabled   *bool         `json:"enabled"`
+}
+
+type AlertRulesRequestInput struct {
+	Account *AccountInput `json:"account"`
+}
+
+type AlertRulesResponse struct {
+	Rules []*AlertRule `json:"rules"`
+}
+
+type AllocationRequestInput struct {
+	Account       *AccountInput        `json:"account"`
+	CurrencyRates []*CurrencyRateInput `json:"currencyRates"`
+}
+
+type AllocationResponse struct {
+	Total            *float64            `json:"total"`
+	ByInstrumentType []*AllocationWeight `json:"byInstrumentType"`
+	ByCurrency       []*AllocationWeight `json:"byCurrency"`
+	BySector         []*AllocationWeight `json:"bySector"`
+	ByCountry        []*AllocationWeight `json:"byCountry"`
+	ByIssuer         []*AllocationWeight `json:"byIssuer"`
+}
+
+type AllocationWeight struct {
+	Key    *string  `json:"key"`
+	Value  *float64 `json:"value"`
+	Weight *float64 `json:"weight"`
+}
+
+type ConsolidatedPortfolioResponse struct {
+	Positions  []*ConsolidatedPosition `json:"positions"`
+	Currencies []*CurrencyBalance      `json:"currencies"`
+	Sources    []*PortfolioSource      `json:"sources"`
+	Failures   []*SourceFailure        `json:"failures"`
+}
+
+type ConsolidatedPosition struct {
+	Figi           *string           `json:"figi"`
+	Ticker         *string           `json:"ticker"`
+	Isin           *string           `json:"isin"`
+	Name           *string           `json:"name"`
+	InstrumentType *string           `json:"instrumentType"`
+	Balance        *float64          `json:"balance"`
+	Value          *Yield            `json:"value"`
+	ExpectedYield  *Yield            `json:"expectedYield"`
+	Sources        []*PositionSource `json:"sources"`
+}
+
+type CurrencyBalance struct {
+	Currency *string  `json:"currency"`
+	Balance  *float64 `json:"balance"`
+	Blocked  *float64 `json:"blocked"`
+}
+
+type CurrencyRateInput struct {
+	Currency *string  `json:"currency"`
+	Rate     *float64 `json:"rate"`
+}
+
+type DeleteAlertRuleRequestInput struct {
+	ID *int `json:"id"`
+}
+
+type DeleteManualAccountRequestInput struct {
+	AccountID *string `json:"accountId"`
+}
+
+type DeleteManualPositionRequestInput struct {
+	AccountID *string `json:"accountId"`
+	Key       *string `json:"key"`
+}
+
+type DeleteNotificationChannelRequestInput struct {
+	ID *int `json:"id"`
+}
+
+type ExportReportRequestInput struct {
+	Account *AccountInput `json:"account"`
+	Report  *string       `json:"report"`
+	Format  *string       `json:"format"`
+	Columns []string      `json:"columns"`
+	Locale  *string       `json:"locale"`
+	From    *string       `json:"from"`
+	To      *string       `json:"to"`
+}
+
+type ExportReportResponse struct {
+	Filename    *string `json:"filename"`
+	ContentType *string `json:"contentType"`
+	Content     *string `json:"content"`
+}
+
+type GenerateStatementRequestInput struct {
+	Account       *AccountInput        `json:"account"`
+	Period        *string              `json:"period"`
+	Date          *string              `json:"date"`
+	Format        *string              `json:"format"`
+	CurrencyRates []*CurrencyRateInput `json:"currencyRates"`
+}
+
+type IisDeduction struct {
+	Contributions  *float64 `json:"contributions"`
+	DeductionBase  *float64 `json:"deductionBase"`
+	Deduction      *float64 `json:"deduction"`
+	RemainingLimit *float64 `json:"remainingLimit"`
+}
+
+type ImportStatementRequestInput struct {
+	Provider *string `json:"provider"`
+	Format   *string `json:"format"`
+	Content  *string `json:"content"`
+}
+
+type ImportStatementResponse struct {
+	Accounts   []*Account `json:"accounts"`
+	Positions  *int       `json:"positions"`
+	Operations *int       `json:"operations"`
+}
+
+type InstrumentInput struct {
+	Figi    *string `json:"figi"`
+	Ticker  *string `json:"ticker"`
+	Isin    *string `json:"isin"`
+	Name    *string `json:"name"`
+	Sector  *string `json:"sector"`
+	Country *string `json:"country"`
+	Issuer  *string `json:"issuer"`
+}
+
+type ManualAccount struct {
+	AccountID   *string      `json:"accountId"`
+	AccountType *AccountType `json:"accountType"`
+	Name        *string      `json:"name"`
+}
+
+type ManualAccountInput struct {
+	AccountID   *string      `json:"accountId"`
+	AccountType *AccountType `json:"accountType"`
+	Name        *string      `json:"name"`
+}
+
+type ManualAccountsResponse struct {
+	Accounts []*ManualAccount `json:"accounts"`
+}
+
+type ManualPosition struct {
+	Figi           *string  `json:"figi"`
+	Ticker         *string  `json:"ticker"`
+	Isin           *string  `json:"isin"`
+	Name           *string  `json:"name"`
+	InstrumentType *string  `json:"instrumentType"`
+	Balance        *float64 `json:"balance"`
+	AveragePrice   *float64 `json:"averagePrice"`
+	Price          *float64 `json:"price"`
+	Currency       *string  `json:"currency"`
+}
+
+type ManualPositionInput struct {
+	Figi           *string  `json:"figi"`
+	Ticker         *string  `json:"ticker"`
+	Isin           *string  `json:"isin"`
+	Name           *string  `json:"name"`
+	InstrumentType *string  `json:"instrumentType"`
+	Balance        *float64 `json:"balance"`
+	AveragePrice   *float64 `json:"averagePrice"`
+	Price          *float64 `json:"price"`
+	Currency       *string  `json:"currency"`
+}
+
+type ManualPositionsRequestInput struct {
+	AccountID *string `json:"accountId"`
+}
+
+type ManualPositionsResponse struct {
+	Positions []*ManualPosition `json:"positions"`
+}
+
+type NotificationChannel struct {
+	ID        *int     `json:"id"`
+	User      *string  `json:"user"`
+	Type      *string  `json:"type"`
+	Target    *string  `json:"target"`
+	Kinds     []string `json:"kinds"`
+	Enabled   *bool    `json:"enabled"`
+	CreatedAt *string  `json:"createdAt"`
+}
+
+type NotificationChannelInput struct {
+	ID      *int     `json:"id"`
+	User    *string  `json:"user"`
+	Type    *string  `json:"type"`
+	Target  *string  `json:"target"`
+	Secret  *string  `json:"secret"`
+	Kinds   []string `json:"kinds"`
+	Enabled *bool    `json:"enabled"`
+}
+
+type NotificationChannelsRequestInput struct {
+	User *string `json:"user"`
+}
+
+type NotificationChannelsResponse struct {
+	Channels []*NotificationChannel `json:"channels"`
+}
+
+type Operation struct {
+	ID             *string        `json:"id"`
+	OperationType  *OperationType `json:"operationType"`
+	Figi           *string        `json:"figi"`
+	InstrumentType *string        `json:"instrumentType"`
+	Date           *string        `json:"date"`
+	Quantity       *int           `json:"quantity"`
+	Price          *float64       `json:"price"`
+	Payment        *float64       `json:"payment"`
+	Currency       *string        `json:"currency"`
+	Commission     *Yield         `json:"commission"`
+	Isin           *string        `json:"isin"`
+	Ticker         *string        `json:"ticker"`
+}
+
+type OperationsRequestInput struct {
+	Account *AccountInput `json:"account"`
+	From    *string       `json:"from"`
+	To      *string       `json:"to"`
+	Figi    *string       `json:"figi"`
+}
+
+type OperationsResponse struct {
+	Operations []*Operation `json:"operations"`
+}
+
 type PortfolioRequestInput struct {
 	Account *AccountInput `json:"account"`
 }
 
 type PortfolioResponse struct {
-	Positions []*Position `json:"positions"`
+	Positions  []*Position        `json:"positions"`
+	Currencies []*CurrencyBalance `json:"currencies"`
+}
+
+type PortfolioSource struct {
+	Provider  *string  `json:"provider"`
+	Account   *Account `json:"account"`
+	Positions *int     `json:"positions"`
 }
 
 type Position struct {
@@ -44,6 +328,192 @@ type Position struct {
 	Name                      *string  `json:"name"`
 }
 
+type PositionSource struct {
+	Provider  *string  `json:"provider"`
+	AccountID *string  `json:"accountId"`
+	Balance   *float64 `json:"balance"`
+	Value     *Yield   `json:"value"`
+}
+
+type Quote struct {
+	Figi     *string  `json:"figi"`
+	Ticker   *string  `json:"ticker"`
+	Price    *float64 `json:"price"`
+	Currency *string  `json:"currency"`
+	Lot      *int     `json:"lot"`
+}
+
+type QuoteRequestInput struct {
+	Figi *string `json:"figi"`
+}
+
+type QuoteResponse struct {
+	Quote *Quote `json:"quote"`
+}
+
+type RebalanceOrder struct {
+	Figi          *string        `json:"figi"`
+	Ticker        *string        `json:"ticker"`
+	OperationType *OperationType `json:"operationType"`
+	Lots          *int           `json:"lots"`
+	Quantity      *int           `json:"quantity"`
+	Price         *float64       `json:"price"`
+	Currency      *string        `json:"currency"`
+	Amount        *float64       `json:"amount"`
+	CurrentWeight *float64       `json:"currentWeight"`
+	TargetWeight  *float64       `json:"targetWeight"`
+	PlannedWeight *float64       `json:"plannedWeight"`
+}
+
+type RebalanceRequestInput struct {
+	Account       *AccountInput        `json:"account"`
+	Tolerance     *float64             `json:"tolerance"`
+	CurrencyRates []*CurrencyRateInput `json:"currencyRates"`
+}
+
+type RebalanceResponse struct {
+	Total      *float64          `json:"total"`
+	CashBefore *float64          `json:"cashBefore"`
+	CashAfter  *float64          `json:"cashAfter"`
+	Orders     []*RebalanceOrder `json:"orders"`
+}
+
+type SaveAlertRuleRequestInput struct {
+	Rule *AlertRuleInput `json:"rule"`
+}
+
+type SaveAlertRuleResponse struct {
+	Rule *AlertRule `json:"rule"`
+}
+
+type SaveInstrumentRequestInput struct {
+	Instrument *InstrumentInput `json:"instrument"`
+}
+
+type SaveManualAccountRequestInput struct {
+	Account *ManualAccountInput `json:"account"`
+}
+
+type SaveManualAccountResponse struct {
+	Account *Account `json:"account"`
+}
+
+type SaveManualPositionRequestInput struct {
+	AccountID *string              `json:"accountId"`
+	Position  *ManualPositionInput `json:"position"`
+}
+
+type SaveNotificationChannelRequestInput struct {
+	Channel *NotificationChannelInput `json:"channel"`
+}
+
+type SaveNotificationChannelResponse struct {
+	Channel *NotificationChannel `json:"channel"`
+}
+
+type SetTargetWeightsRequestInput struct {
+	Account *AccountInput        `json:"account"`
+	Weights []*TargetWeightInput `json:"weights"`
+}
+
+type SourceFailure struct {
+	Provider  *string `json:"provider"`
+	AccountID *string `json:"accountId"`
+	Error     *string `json:"error"`
+}
+
+type Statement struct {
+	ID          *int     `json:"id"`
+	Account     *Account `json:"account"`
+	Period      *string  `json:"period"`
+	From        *string  `json:"from"`
+	To          *string  `json:"to"`
+	Format      *string  `json:"format"`
+	Filename    *string  `json:"filename"`
+	ContentType *string  `json:"contentType"`
+	CreatedAt   *string  `json:"createdAt"`
+}
+
+type StatementRequestInput struct {
+	ID *int `json:"id"`
+}
+
+type StatementResponse struct {
+	Statement *Statement `json:"statement"`
+	Content   *string    `json:"content"`
+}
+
+type StatementsRequestInput struct {
+	Account *AccountInput `json:"account"`
+}
+
+type StatementsResponse struct {
+	Statements []*Statement `json:"statements"`
+}
+
+type TargetWeight struct {
+	Figi   *string  `json:"figi"`
+	Weight *float64 `json:"weight"`
+}
+
+type TargetWeightInput struct {
+	Figi   *string  `json:"figi"`
+	Weight *float64 `json:"weight"`
+}
+
+type TargetWeightsRequestInput struct {
+	Account *AccountInput `json:"account"`
+}
+
+type TargetWeightsResponse struct {
+	Weights []*TargetWeight `json:"weights"`
+}
+
+type TaxReport struct {
+	Account          *Account      `json:"account"`
+	Year             *int          `json:"year"`
+	RealizedGain     *float64      `json:"realizedGain"`
+	RealizedLoss     *float64      `json:"realizedLoss"`
+	ExemptGain       *float64      `json:"exemptGain"`
+	CouponIncome     *float64      `json:"couponIncome"`
+	DividendIncome   *float64      `json:"dividendIncome"`
+	ForeignTaxCredit *float64      `json:"foreignTaxCredit"`
+	TaxBase          *float64      `json:"taxBase"`
+	TaxEstimate      *float64      `json:"taxEstimate"`
+	TaxWithheld      *float64      `json:"taxWithheld"`
+	TaxDue           *float64      `json:"taxDue"`
+	Iis              *IisDeduction `json:"iis"`
+}
+
+type TaxReportRequestInput struct {
+	Account       *AccountInput        `json:"account"`
+	Year          *int                 `json:"year"`
+	CurrencyRates []*CurrencyRateInput `json:"currencyRates"`
+}
+
+type TaxReportResponse struct {
+	Report *TaxReport `json:"report"`
+}
+
+type TelegramLinkRequestInput struct {
+	User *string `json:"user"`
+}
+
+type TelegramLinkResponse struct {
+	Code      *string `json:"code"`
+	ExpiresAt *string `json:"expiresAt"`
+}
+
+type UpdateManualPriceRequestInput struct {
+	AccountID *string  `json:"accountId"`
+	Key       *string  `json:"key"`
+	Price     *float64 `json:"price"`
+}
+
+type UpdateManualPriceResponse struct {
+	Updated *int `json:"updated"`
+}
+
 type Yield struct {
 	Currency *string  `json:"currency"`
 	Value    *float64 `json:"value"`
@@ -91,3 +561,117 @@ func (e *AccountType) UnmarshalGQL(v interface{}) error {
 func (e AccountType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type AlertType string
+
+const (
+	AlertTypeAlertTypeUnspecified          AlertType = "ALERT_TYPE_UNSPECIFIED"
+	AlertTypeAlertTypePriceAbove           AlertType = "ALERT_TYPE_PRICE_ABOVE"
+	AlertTypeAlertTypePriceBelow           AlertType = "ALERT_TYPE_PRICE_BELOW"
+	AlertTypeAlertTypePositionDrawdown     AlertType = "ALERT_TYPE_POSITION_DRAWDOWN"
+	AlertTypeAlertTypePortfolioDailyChange AlertType = "ALERT_TYPE_PORTFOLIO_DAILY_CHANGE"
+)
+
+var AllAlertType = []AlertType{
+	AlertTypeAlertTypeUnspecified,
+	AlertTypeAlertTypePriceAbove,
+	AlertTypeAlertTypePriceBelow,
+	AlertTypeAlertTypePositionDrawdown,
+	AlertTypeAlertTypePortfolioDailyChange,
+}
+
+func (e AlertType) IsValid() bool {
+	switch e {
+	case AlertTypeAlertTypeUnspecified, AlertTypeAlertTypePriceAbove, AlertTypeAlertTypePriceBelow, AlertTypeAlertTypePositionDrawdown, AlertTypeAlertTypePortfolioDailyChange:
+		return true
+	}
+	return false
+}
+
+func (e AlertType) String() string {
+	return string(e)
+}
+
+func (e *AlertType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AlertType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AlertType", str)
+	}
+	return nil
+}
+
+func (e AlertType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OperationType string
+
+const (
+	OperationTypeOperationTypeUnspecified OperationType = "OPERATION_TYPE_UNSPECIFIED"
+	OperationTypeOperationTypeBuy         OperationType = "OPERATION_TYPE_BUY"
+	OperationTypeOperationTypeSell        OperationType = "OPERATION_TYPE_SELL"
+	OperationTypeOperationTypePayIn       OperationType = "OPERATION_TYPE_PAY_IN"
+	OperationTypeOperationTypePayOut      OperationType = "OPERATION_TYPE_PAY_OUT"
+	OperationTypeOperationTypeDividend    OperationType = "OPERATION_TYPE_DIVIDEND"
+	OperationTypeOperationTypeCoupon      OperationType = "OPERATION_TYPE_COUPON"
+	OperationTypeOperationTypeRepayment   OperationType = "OPERATION_TYPE_REPAYMENT"
+	OperationTypeOperationTypeCommission  OperationType = "OPERATION_TYPE_COMMISSION"
+	OperationTypeOperationTypeTax         OperationType = "OPERATION_TYPE_TAX"
+	OperationTypeOperationTypeTaxDividend OperationType = "OPERATION_TYPE_TAX_DIVIDEND"
+	OperationTypeOperationTypeTaxCoupon   OperationType = "OPERATION_TYPE_TAX_COUPON"
+	OperationTypeOperationTypeTaxBack     OperationType = "OPERATION_TYPE_TAX_BACK"
+	OperationTypeOperationTypeSecurityIn  OperationType = "OPERATION_TYPE_SECURITY_IN"
+	OperationTypeOperationTypeSecurityOut OperationType = "OPERATION_TYPE_SECURITY_OUT"
+)
+
+var AllOperationType = []OperationType{
+	OperationTypeOperationTypeUnspecified,
+	OperationTypeOperationTypeBuy,
+	OperationTypeOperationTypeSell,
+	OperationTypeOperationTypePayIn,
+	OperationTypeOperationTypePayOut,
+	OperationTypeOperationTypeDividend,
+	OperationTypeOperationTypeCoupon,
+	OperationTypeOperationTypeRepayment,
+	OperationTypeOperationTypeCommission,
+	OperationTypeOperationTypeTax,
+	OperationTypeOperationTypeTaxDividend,
+	OperationTypeOperationTypeTaxCoupon,
+	OperationTypeOperationTypeTaxBack,
+	OperationTypeOperationTypeSecurityIn,
+	OperationTypeOperationTypeSecurityOut,
+}
+
+func (e OperationType) IsValid() bool {
+	switch e {
+	case OperationTypeOperationTypeUnspecified, OperationTypeOperationTypeBuy, OperationTypeOperationTypeSell, OperationTypeOperationTypePayIn, OperationTypeOperationTypePayOut, OperationTypeOperationTypeDividend, OperationTypeOperationTypeCoupon, OperationTypeOperationTypeRepayment, OperationTypeOperationTypeCommission, OperationTypeOperationTypeTax, OperationTypeOperationTypeTaxDividend, OperationTypeOperationTypeTaxCoupon, OperationTypeOperationTypeTaxBack, OperationTypeOperationTypeSecurityIn, OperationTypeOperationTypeSecurityOut:
+		return true
+	}
+	return false
+}
+
+func (e OperationType) String() string {
+	return string(e)
+}
+
+func (e *OperationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OperationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OperationType", str)
+	}
+	return nil
+}
+
+func (e OperationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -20,6 +20,35 @@ const _ = grpc.SupportPackageIsVersion7
 type InvestServiceClient interface {
 	GetPortfolio(ctx context.Context, in *PortfolioRequest, opts ...grpc.CallOption) (*PortfolioResponse, error)
 	GetAccounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	GetOperations(ctx context.Context, in *OperationsRequest, opts ...grpc.CallOption) (*OperationsResponse, error)
+	GetTaxReport(ctx context.Context, in *TaxReportRequest, opts ...grpc.CallOption) (*TaxReportResponse, error)
+	GetAllocation(ctx context.Context, in *AllocationRequest, opts ...grpc.CallOption) (*AllocationResponse, error)
+	SaveInstrument(ctx context.Context, in *SaveInstrumentRequest, opts ...grpc.CallOption) (*SaveInstrumentResponse, error)
+	GetQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	SetTargetWeights(ctx context.Context, in *SetTargetWeightsRequest, opts ...grpc.CallOption) (*SetTargetWeightsResponse, error)
+	GetTargetWeights(ctx context.Context, in *TargetWeightsRequest, opts ...grpc.CallOption) (*TargetWeightsResponse, error)
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
+	GetConsolidatedPortfolio(ctx context.Context, in *ConsolidatedPortfolioRequest, opts ...grpc.CallOption) (*ConsolidatedPortfolioResponse, error)
+	ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error)
+	GetManualAccounts(ctx context.Context, in *ManualAccountsRequest, opts ...grpc.CallOption) (*ManualAccountsResponse, error)
+	SaveManualAccount(ctx context.Context, in *SaveManualAccountRequest, opts ...grpc.CallOption) (*SaveManualAccountResponse, error)
+	DeleteManualAccount(ctx context.Context, in *DeleteManualAccountRequest, opts ...grpc.CallOption) (*DeleteManualAccountResponse, error)
+	GetManualPositions(ctx context.Context, in *ManualPositionsRequest, opts ...grpc.CallOption) (*ManualPositionsResponse, error)
+	SaveManualPosition(ctx context.Context, in *SaveManualPositionRequest, opts ...grpc.CallOption) (*SaveManualPositionResponse, error)
+	DeleteManualPosition(ctx context.Context, in *DeleteManualPositionRequest, opts ...grpc.CallOption) (*DeleteManualPositionResponse, error)
+	UpdateManualPrice(ctx context.Context, in *UpdateManualPriceRequest, opts ...grpc.CallOption) (*UpdateManualPriceResponse, error)
+	ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (*ExportReportResponse, error)
+	GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error)
+	GetStatements(ctx context.Context, in *StatementsRequest, opts ...grpc.CallOption) (*StatementsResponse, error)
+	GetStatement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*StatementResponse, error)
+	GetAlertRules(ctx context.Context, in *AlertRulesRequest, opts ...grpc.CallOption) (*AlertRulesResponse, error)
+	SaveAlertRule(ctx context.Context, in *SaveAlertRuleRequest, opts ...grpc.CallOption) (*SaveAlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	GetAlertEvents(ctx context.Context, in *AlertEventsRequest, opts ...grpc.CallOption) (*AlertEventsResponse, error)
+	GetNotificationChannels(ctx context.Context, in *NotificationChannelsRequest, opts ...grpc.CallOption) (*NotificationChannelsResponse, error)
+	SaveNotificationChannel(ctx context.Context, in *SaveNotificationChannelRequest, opts ...grpc.CallOption) (*SaveNotificationChannelResponse, error)
+	DeleteNotificationChannel(ctx context.Context, in *DeleteNotificationChannelRequest, opts ...grpc.CallOption) (*DeleteNotificationChannelResponse, error)
+	CreateTelegramLink(ctx context.Context, in *TelegramLinkRequest, opts ...grpc.CallOption) (*TelegramLinkResponse, error)
 }
 
 type investServiceClient struct {
@@ -48,12 +77,302 @@ func (c *investServiceClient) GetAccounts(ctx context.Context, in *AccountsReque
 	return out, nil
 }
 
+func (c *investServiceClient) GetOperations(ctx context.Context, in *OperationsRequest, opts ...grpc.CallOption) (*OperationsResponse, error) {
+	out := new(OperationsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetTaxReport(ctx context.Context, in *TaxReportRequest, opts ...grpc.CallOption) (*TaxReportResponse, error) {
+	out := new(TaxReportResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetTaxReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetAllocation(ctx context.Context, in *AllocationRequest, opts ...grpc.CallOption) (*AllocationResponse, error) {
+	out := new(AllocationResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) SaveInstrument(ctx context.Context, in *SaveInstrumentRequest, opts ...grpc.CallOption) (*SaveInstrumentResponse, error) {
+	out := new(SaveInstrumentResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/SaveInstrument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) SetTargetWeights(ctx context.Context, in *SetTargetWeightsRequest, opts ...grpc.CallOption) (*SetTargetWeightsResponse, error) {
+	out := new(SetTargetWeightsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/SetTargetWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetTargetWeights(ctx context.Context, in *TargetWeightsRequest, opts ...grpc.CallOption) (*TargetWeightsResponse, error) {
+	out := new(TargetWeightsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetTargetWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	out := new(RebalanceResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetConsolidatedPortfolio(ctx context.Context, in *ConsolidatedPortfolioRequest, opts ...grpc.CallOption) (*ConsolidatedPortfolioResponse, error) {
+	out := new(ConsolidatedPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetConsolidatedPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error) {
+	out := new(ImportStatementResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/ImportStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetManualAccounts(ctx context.Context, in *ManualAccountsRequest, opts ...grpc.CallOption) (*ManualAccountsResponse, error) {
+	out := new(ManualAccountsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetManualAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) SaveManualAccount(ctx context.Context, in *SaveManualAccountRequest, opts ...grpc.CallOption) (*SaveManualAccountResponse, error) {
+	out := new(SaveManualAccountResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/SaveManualAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) DeleteManualAccount(ctx context.Context, in *DeleteManualAccountRequest, opts ...grpc.CallOption) (*DeleteManualAccountResponse, error) {
+	out := new(DeleteManualAccountResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/DeleteManualAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetManualPositions(ctx context.Context, in *ManualPositionsRequest, opts ...grpc.CallOption) (*ManualPositionsResponse, error) {
+	out := new(ManualPositionsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetManualPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) SaveManualPosition(ctx context.Context, in *SaveManualPositionRequest, opts ...grpc.CallOption) (*SaveManualPositionResponse, error) {
+	out := new(SaveManualPositionResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/SaveManualPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) DeleteManualPosition(ctx context.Context, in *DeleteManualPositionRequest, opts ...grpc.CallOption) (*DeleteManualPositionResponse, error) {
+	out := new(DeleteManualPositionResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/DeleteManualPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) UpdateManualPrice(ctx context.Context, in *UpdateManualPriceRequest, opts ...grpc.CallOption) (*UpdateManualPriceResponse, error) {
+	out := new(UpdateManualPriceResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/UpdateManualPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (*ExportReportResponse, error) {
+	out := new(ExportReportResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/ExportReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error) {
+	out := new(GenerateStatementResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GenerateStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetStatements(ctx context.Context, in *StatementsRequest, opts ...grpc.CallOption) (*StatementsResponse, error) {
+	out := new(StatementsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetStatements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetStatement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*StatementResponse, error) {
+	out := new(StatementResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetAlertRules(ctx context.Context, in *AlertRulesRequest, opts ...grpc.CallOption) (*AlertRulesResponse, error) {
+	out := new(AlertRulesResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetAlertRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) SaveAlertRule(ctx context.Context, in *SaveAlertRuleRequest, opts ...grpc.CallOption) (*SaveAlertRuleResponse, error) {
+	out := new(SaveAlertRuleResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/SaveAlertRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/DeleteAlertRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetAlertEvents(ctx context.Context, in *AlertEventsRequest, opts ...grpc.CallOption) (*AlertEventsResponse, error) {
+	out := new(AlertEventsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetAlertEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) GetNotificationChannels(ctx context.Context, in *NotificationChannelsRequest, opts ...grpc.CallOption) (*NotificationChannelsResponse, error) {
+	out := new(NotificationChannelsResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/GetNotificationChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) SaveNotificationChannel(ctx context.Context, in *SaveNotificationChannelRequest, opts ...grpc.CallOption) (*SaveNotificationChannelResponse, error) {
+	out := new(SaveNotificationChannelResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/SaveNotificationChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) DeleteNotificationChannel(ctx context.Context, in *DeleteNotificationChannelRequest, opts ...grpc.CallOption) (*DeleteNotificationChannelResponse, error) {
+	out := new(DeleteNotificationChannelResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/DeleteNotificationChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *investServiceClient) CreateTelegramLink(ctx context.Context, in *TelegramLinkRequest, opts ...grpc.CallOption) (*TelegramLinkResponse, error) {
+	out := new(TelegramLinkResponse)
+	err := c.cc.Invoke(ctx, "/invest.v1.InvestService/CreateTelegramLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvestServiceServer is the server API for InvestService service.
 // All implementations must embed UnimplementedInvestServiceServer
 // for forward compatibility
 type InvestServiceServer interface {
 	GetPortfolio(context.Context, *PortfolioRequest) (*PortfolioResponse, error)
 	GetAccounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
+	GetOperations(context.Context, *OperationsRequest) (*OperationsResponse, error)
+	GetTaxReport(context.Context, *TaxReportRequest) (*TaxReportResponse, error)
+	GetAllocation(context.Context, *AllocationRequest) (*AllocationResponse, error)
+	SaveInstrument(context.Context, *SaveInstrumentRequest) (*SaveInstrumentResponse, error)
+	GetQuote(context.Context, *QuoteRequest) (*QuoteResponse, error)
+	SetTargetWeights(context.Context, *SetTargetWeightsRequest) (*SetTargetWeightsResponse, error)
+	GetTargetWeights(context.Context, *TargetWeightsRequest) (*TargetWeightsResponse, error)
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
+	GetConsolidatedPortfolio(context.Context, *ConsolidatedPortfolioRequest) (*ConsolidatedPortfolioResponse, error)
+	ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error)
+	GetManualAccounts(context.Context, *ManualAccountsRequest) (*ManualAccountsResponse, error)
+	SaveManualAccount(context.Context, *SaveManualAccountRequest) (*SaveManualAccountResponse, error)
+	DeleteManualAccount(context.Context, *DeleteManualAccountRequest) (*DeleteManualAccountResponse, error)
+	GetManualPositions(context.Context, *ManualPositionsRequest) (*ManualPositionsResponse, error)
+	SaveManualPosition(context.Context, *SaveManualPositionRequest) (*SaveManualPositionResponse, error)
+	DeleteManualPosition(context.Context, *DeleteManualPositionRequest) (*DeleteManualPositionResponse, error)
+	UpdateManualPrice(context.Context, *UpdateManualPriceRequest) (*UpdateManualPriceResponse, error)
+	ExportReport(context.Context, *ExportReportRequest) (*ExportReportResponse, error)
+	GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error)
+	GetStatements(context.Context, *StatementsRequest) (*StatementsResponse, error)
+	GetStatement(context.Context, *StatementRequest) (*StatementResponse, error)
+	GetAlertRules(context.Context, *AlertRulesRequest) (*AlertRulesResponse, error)
+	SaveAlertRule(context.Context, *SaveAlertRuleRequest) (*SaveAlertRuleResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	GetAlertEvents(context.Context, *AlertEventsRequest) (*AlertEventsResponse, error)
+	GetNotificationChannels(context.Context, *NotificationChannelsRequest) (*NotificationChannelsResponse, error)
+	SaveNotificationChannel(context.Context, *SaveNotificationChannelRequest) (*SaveNotificationChannelResponse, error)
+	DeleteNotificationChannel(context.Context, *DeleteNotificationChannelRequest) (*DeleteNotificationChannelResponse, error)
+	CreateTelegramLink(context.Context, *TelegramLinkRequest) (*TelegramLinkResponse, error)
 	mustEmbedUnimplementedInvestServiceServer()
 }
 
@@ -67,6 +386,93 @@ func (UnimplementedInvestServiceServer) GetPortfolio(context.Context, *Portfolio
 func (UnimplementedInvestServiceServer) GetAccounts(context.Context, *AccountsRequest) (*AccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
 }
+func (UnimplementedInvestServiceServer) GetOperations(context.Context, *OperationsRequest) (*OperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperations not implemented")
+}
+func (UnimplementedInvestServiceServer) GetTaxReport(context.Context, *TaxReportRequest) (*TaxReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxReport not implemented")
+}
+func (UnimplementedInvestServiceServer) GetAllocation(context.Context, *AllocationRequest) (*AllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllocation not implemented")
+}
+func (UnimplementedInvestServiceServer) SaveInstrument(context.Context, *SaveInstrumentRequest) (*SaveInstrumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveInstrument not implemented")
+}
+func (UnimplementedInvestServiceServer) GetQuote(context.Context, *QuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedInvestServiceServer) SetTargetWeights(context.Context, *SetTargetWeightsRequest) (*SetTargetWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTargetWeights not implemented")
+}
+func (UnimplementedInvestServiceServer) GetTargetWeights(context.Context, *TargetWeightsRequest) (*TargetWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetWeights not implemented")
+}
+func (UnimplementedInvestServiceServer) Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebalance not implemented")
+}
+func (UnimplementedInvestServiceServer) GetConsolidatedPortfolio(context.Context, *ConsolidatedPortfolioRequest) (*ConsolidatedPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedPortfolio not implemented")
+}
+func (UnimplementedInvestServiceServer) ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedInvestServiceServer) GetManualAccounts(context.Context, *ManualAccountsRequest) (*ManualAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManualAccounts not implemented")
+}
+func (UnimplementedInvestServiceServer) SaveManualAccount(context.Context, *SaveManualAccountRequest) (*SaveManualAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveManualAccount not implemented")
+}
+func (UnimplementedInvestServiceServer) DeleteManualAccount(context.Context, *DeleteManualAccountRequest) (*DeleteManualAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteManualAccount not implemented")
+}
+func (UnimplementedInvestServiceServer) GetManualPositions(context.Context, *ManualPositionsRequest) (*ManualPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManualPositions not implemented")
+}
+func (UnimplementedInvestServiceServer) SaveManualPosition(context.Context, *SaveManualPositionRequest) (*SaveManualPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveManualPosition not implemented")
+}
+func (UnimplementedInvestServiceServer) DeleteManualPosition(context.Context, *DeleteManualPositionRequest) (*DeleteManualPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteManualPosition not implemented")
+}
+func (UnimplementedInvestServiceServer) UpdateManualPrice(context.Context, *UpdateManualPriceRequest) (*UpdateManualPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateManualPrice not implemented")
+}
+func (UnimplementedInvestServiceServer) ExportReport(context.Context, *ExportReportRequest) (*ExportReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReport not implemented")
+}
+func (UnimplementedInvestServiceServer) GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedInvestServiceServer) GetStatements(context.Context, *StatementsRequest) (*StatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatements not implemented")
+}
+func (UnimplementedInvestServiceServer) GetStatement(context.Context, *StatementRequest) (*StatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedInvestServiceServer) GetAlertRules(context.Context, *AlertRulesRequest) (*AlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertRules not implemented")
+}
+func (UnimplementedInvestServiceServer) SaveAlertRule(context.Context, *SaveAlertRuleRequest) (*SaveAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAlertRule not implemented")
+}
+func (UnimplementedInvestServiceServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedInvestServiceServer) GetAlertEvents(context.Context, *AlertEventsRequest) (*AlertEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertEvents not implemented")
+}
+func (UnimplementedInvestServiceServer) GetNotificationChannels(context.Context, *NotificationChannelsRequest) (*NotificationChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationChannels not implemented")
+}
+func (UnimplementedInvestServiceServer) SaveNotificationChannel(context.Context, *SaveNotificationChannelRequest) (*SaveNotificationChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveNotificationChannel not implemented")
+}
+func (UnimplementedInvestServiceServer) DeleteNotificationChannel(context.Context, *DeleteNotificationChannelRequest) (*DeleteNotificationChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationChannel not implemented")
+}
+func (UnimplementedInvestServiceServer) CreateTelegramLink(context.Context, *TelegramLinkRequest) (*TelegramLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTelegramLink not implemented")
+}
 func (UnimplementedInvestServiceServer) mustEmbedUnimplementedInvestServiceServer() {}
 
 // UnsafeInvestServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -116,20 +522,658 @@ func _InvestService_GetAccounts_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// InvestService_ServiceDesc is the grpc.ServiceDesc for InvestService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var InvestService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "invest.v1.InvestService",
-	HandlerType: (*InvestServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetPortfolio",
-			Handler:    _InvestService_GetPortfolio_Handler,
-		},
-		{
-			MethodName: "GetAccounts",
-			Handler:    _InvestService_GetAccounts_Handler,
+func _InvestService_GetOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetOperations(ctx, req.(*OperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetTaxReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaxReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetTaxReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetTaxReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetTaxReport(ctx, req.(*TaxReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetAllocation(ctx, req.(*AllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_SaveInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveInstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).SaveInstrument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/SaveInstrument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).SaveInstrument(ctx, req.(*SaveInstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_SetTargetWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).SetTargetWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/SetTargetWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).SetTargetWeights(ctx, req.(*SetTargetWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetTargetWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TargetWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetTargetWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetTargetWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetTargetWeights(ctx, req.(*TargetWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetConsolidatedPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidatedPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetConsolidatedPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetConsolidatedPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetConsolidatedPortfolio(ctx, req.(*ConsolidatedPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_ImportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).ImportStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/ImportStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).ImportStatement(ctx, req.(*ImportStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetManualAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetManualAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetManualAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetManualAccounts(ctx, req.(*ManualAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_SaveManualAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveManualAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).SaveManualAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/SaveManualAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).SaveManualAccount(ctx, req.(*SaveManualAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_DeleteManualAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManualAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).DeleteManualAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/DeleteManualAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).DeleteManualAccount(ctx, req.(*DeleteManualAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetManualPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetManualPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetManualPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetManualPositions(ctx, req.(*ManualPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_SaveManualPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveManualPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).SaveManualPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/SaveManualPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).SaveManualPosition(ctx, req.(*SaveManualPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_DeleteManualPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManualPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).DeleteManualPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/DeleteManualPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).DeleteManualPosition(ctx, req.(*DeleteManualPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_UpdateManualPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateManualPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).UpdateManualPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/UpdateManualPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).UpdateManualPrice(ctx, req.(*UpdateManualPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_ExportReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).ExportReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/ExportReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).ExportReport(ctx, req.(*ExportReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GenerateStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GenerateStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GenerateStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GenerateStatement(ctx, req.(*GenerateStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetStatements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetStatements(ctx, req.(*StatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetStatement(ctx, req.(*StatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetAlertRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetAlertRules(ctx, req.(*AlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_SaveAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).SaveAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/SaveAlertRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).SaveAlertRule(ctx, req.(*SaveAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/DeleteAlertRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetAlertEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetAlertEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetAlertEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetAlertEvents(ctx, req.(*AlertEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_GetNotificationChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).GetNotificationChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/GetNotificationChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).GetNotificationChannels(ctx, req.(*NotificationChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_SaveNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveNotificationChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).SaveNotificationChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/SaveNotificationChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).SaveNotificationChannel(ctx, req.(*SaveNotificationChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_DeleteNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).DeleteNotificationChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/DeleteNotificationChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).DeleteNotificationChannel(ctx, req.(*DeleteNotificationChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvestService_CreateTelegramLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TelegramLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvestServiceServer).CreateTelegramLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invest.v1.InvestService/CreateTelegramLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvestServiceServer).CreateTelegramLink(ctx, req.(*TelegramLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InvestService_ServiceDesc is the grpc.ServiceDesc for InvestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InvestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "invest.v1.InvestService",
+	HandlerType: (*InvestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPortfolio",
+			Handler:    _InvestService_GetPortfolio_Handler,
+		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _InvestService_GetAccounts_Handler,
+		},
+		{
+			MethodName: "GetOperations",
+			Handler:    _InvestService_GetOperations_Handler,
+		},
+		{
+			MethodName: "GetTaxReport",
+			Handler:    _InvestService_GetTaxReport_Handler,
+		},
+		{
+			MethodName: "GetAllocation",
+			Handler:    _InvestService_GetAllocation_Handler,
+		},
+		{
+			MethodName: "SaveInstrument",
+			Handler:    _InvestService_SaveInstrument_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _InvestService_GetQuote_Handler,
+		},
+		{
+			MethodName: "SetTargetWeights",
+			Handler:    _InvestService_SetTargetWeights_Handler,
+		},
+		{
+			MethodName: "GetTargetWeights",
+			Handler:    _InvestService_GetTargetWeights_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _InvestService_Rebalance_Handler,
+		},
+		{
+			MethodName: "GetConsolidatedPortfolio",
+			Handler:    _InvestService_GetConsolidatedPortfolio_Handler,
+		},
+		{
+			MethodName: "ImportStatement",
+			Handler:    _InvestService_ImportStatement_Handler,
+		},
+		{
+			MethodName: "GetManualAccounts",
+			Handler:    _InvestService_GetManualAccounts_Handler,
+		},
+		{
+			MethodName: "SaveManualAccount",
+			Handler:    _InvestService_SaveManualAccount_Handler,
+		},
+		{
+			MethodName: "DeleteManualAccount",
+			Handler:    _InvestService_DeleteManualAccount_Handler,
+		},
+		{
+			MethodName: "GetManualPositions",
+			Handler:    _InvestService_GetManualPositions_Handler,
+		},
+		{
+			MethodName: "SaveManualPosition",
+			Handler:    _InvestService_SaveManualPosition_Handler,
+		},
+		{
+			MethodName: "DeleteManualPosition",
+			Handler:    _InvestService_DeleteManualPosition_Handler,
+		},
+		{
+			MethodName: "UpdateManualPrice",
+			Handler:    _InvestService_UpdateManualPrice_Handler,
+		},
+		{
+			MethodName: "ExportReport",
+			Handler:    _InvestService_ExportReport_Handler,
+		},
+		{
+			MethodName: "GenerateStatement",
+			Handler:    _InvestService_GenerateStatement_Handler,
+		},
+		{
+			MethodName: "GetStatements",
+			Handler:    _InvestService_GetStatements_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _InvestService_GetStatement_Handler,
+		},
+		{
+			MethodName: "GetAlertRules",
+			Handler:    _InvestService_GetAlertRules_Handler,
+		},
+		{
+			MethodName: "SaveAlertRule",
+			Handler:    _InvestService_SaveAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _InvestService_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "GetAlertEvents",
+			Handler:    _InvestService_GetAlertEvents_Handler,
+		},
+		{
+			MethodName: "GetNotificationChannels",
+			Handler:    _InvestService_GetNotificationChannels_Handler,
+		},
+		{
+			MethodName: "SaveNotificationChannel",
+			Handler:    _InvestService_SaveNotificationChannel_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationChannel",
+			Handler:    _InvestService_DeleteNotificationChannel_Handler,
+		},
+		{
+			MethodName: "CreateTelegramLink",
+			Handler:    _InvestService_CreateTelegramLink_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,182 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// Rules returns alert rules of the requested account, or all rules when account is not set.
+func Rules(ctx context.Context, storage invest.AlertStorage, req *pb.AlertRulesRequest) (*pb.AlertRulesResponse, error) {
+
+	var provider, accountID string
+	if req.Account != nil {
+		provider, accountID = req.Account.Provider, req.Account.AccountId
+	}
+
+	rules, err := storage.AlertRules(ctx, provider, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AlertRulesResponse{Rules: make([]*pb.AlertRule, 0, len(rules))}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, toPbRule(rule))
+	}
+	return resp, nil
+}
+
+// SaveRule validates and stores alert rule, rule without id is created.
+// Updating rule resets its evaluation state, so it may trigger again.
+func SaveRule(ctx context.Context, storage invest.AlertStorage, req *pb.SaveAlertRuleRequest) (*pb.SaveAlertRuleResponse, error) {
+
+	if req.Rule == nil {
+		return nil, fmt.Errorf("%w: rule is required", invest.ErrInvalidArgument)
+	}
+	if err := validate(req.Rule); err != nil {
+		return nil, err
+	}
+
+	rule := invest.AlertRule{
+		ID:        req.Rule.Id,
+		Type:      req.Rule.Type,
+		Name:      req.Rule.Name,
+		Provider:  req.Rule.Account.GetProvider(),
+		AccountID: req.Rule.Account.GetAccountId(),
+		Figi:      req.Rule.Figi,
+		Threshold: req.Rule.Threshold,
+		Enabled:   req.Rule.Enabled,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	if err := storage.SaveAlertRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	// update keeps creation time, so stored rule is returned
+	if req.Rule.Id != 0 {
+		rules, err := storage.AlertRules(ctx, rule.Provider, rule.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		for _, stored := range rules {
+			if stored.ID == rule.ID {
+				rule = stored
+				break
+			}
+		}
+	}
+
+	return &pb.SaveAlertRuleResponse{Rule: toPbRule(rule)}, nil
+}
+
+// DeleteRule deletes alert rule and its history.
+func DeleteRule(ctx context.Context, storage invest.AlertStorage, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+
+	if req.Id <= 0 {
+		return nil, fmt.Errorf("%w: rule id is required", invest.ErrInvalidArgument)
+	}
+	if err := storage.DeleteAlertRule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAlertRuleResponse{}, nil
+}
+
+// Events returns triggered alerts, newest first, filtered by rule, account and time.
+func Events(ctx context.Context, storage invest.AlertStorage, req *pb.AlertEventsRequest) (*pb.AlertEventsResponse, error) {
+
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("%w: limit must not be negative", invest.ErrInvalidArgument)
+	}
+	filter := invest.AlertEventFilter{
+		RuleID:    req.RuleId,
+		Provider:  req.Account.GetProvider(),
+		AccountID: req.Account.GetAccountId(),
+		Limit:     int(req.Limit),
+	}
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parse from: %s", invest.ErrInvalidArgument, err)
+		}
+		filter.From = from
+	}
+
+	events, err := storage.AlertEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AlertEventsResponse{Events: make([]*pb.AlertEvent, 0, len(events))}
+	for _, event := range events {
+		resp.Events = append(resp.Events, toPbEvent(event))
+	}
+	return resp, nil
+}
+
+func validate(rule *pb.AlertRule) error {
+	switch rule.Type {
+	case pb.AlertType_ALERT_TYPE_PRICE_ABOVE, pb.AlertType_ALERT_TYPE_PRICE_BELOW:
+		if rule.Figi == "" {
+			return fmt.Errorf("%w: figi is required for price alert", invest.ErrInvalidArgument)
+		}
+		if rule.Threshold <= 0 {
+			return fmt.Errorf("%w: price threshold must be positive", invest.ErrInvalidArgument)
+		}
+	case pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN:
+		if rule.Account.GetAccountId() == "" || rule.Figi == "" {
+			return fmt.Errorf("%w: account and figi are required for drawdown alert", invest.ErrInvalidArgument)
+		}
+		if rule.Threshold <= 0 || rule.Threshold >= 100 {
+			return fmt.Errorf("%w: drawdown threshold must be a percent between 0 and 100", invest.ErrInvalidArgument)
+		}
+	case pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE:
+		if rule.Account.GetAccountId() == "" {
+			return fmt.Errorf("%w: account is required for daily change alert", invest.ErrInvalidArgument)
+		}
+		if rule.Threshold <= 0 {
+			return fmt.Errorf("%w: daily change threshold must be a positive percent", invest.ErrInvalidArgument)
+		}
+	default:
+		return fmt.Errorf("%w: unknown alert type %s", invest.ErrInvalidArgument, rule.Type)
+	}
+	return nil
+}
+
+func toPbRule(rule invest.AlertRule) *pb.AlertRule {
+	r := &pb.AlertRule{
+		Id:        rule.ID,
+		Type:      rule.Type,
+		Name:      rule.Name,
+		Figi:      rule.Figi,
+		Threshold: rule.Threshold,
+		Enabled:   rule.Enabled,
+		CreatedAt: rule.CreatedAt.Format(time.RFC3339),
+	}
+	if rule.AccountID != "" {
+		r.Account = &pb.Account{AccountId: rule.AccountID, Provider: rule.Provider}
+	}
+	if !rule.TriggeredAt.IsZero() {
+		r.TriggeredAt = rule.TriggeredAt.Format(time.RFC3339)
+	}
+	return r
+}
+
+func toPbEvent(event invest.AlertEvent) *pb.AlertEvent {
+	e := &pb.AlertEvent{
+		Id:          event.ID,
+		RuleId:      event.RuleID,
+		Type:        event.Type,
+		Figi:        event.Figi,
+		Threshold:   event.Threshold,
+		Value:       event.Value,
+		Message:     event.Message,
+		TriggeredAt: event.TriggeredAt.Format(time.RFC3339),
+	}
+	if event.AccountID != "" {
+		e.Account = &pb.Account{AccountId: event.AccountID, Provider: event.Provider}
+	}
+	return e
+}
@@ -0,0 +1,341 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"testing"
+	"time"
+)
+
+type testProvider struct {
+	invest.Provider
+	prices         map[string]float64
+	positions      []*pb.Position
+	quoteCalls     int
+	portfolioCalls int
+}
+
+func (p *testProvider) Quote(_ context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	p.quoteCalls++
+	price, found := p.prices[req.Figi]
+	if !found {
+		return nil, invest.ErrNotFound
+	}
+	return &pb.QuoteResponse{Quote: &pb.Quote{Figi: req.Figi, Price: price, Currency: "RUB"}}, nil
+}
+
+func (p *testProvider) Portfolio(context.Context, *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	p.portfolioCalls++
+	return &pb.PortfolioResponse{
+		Positions:  p.positions,
+		Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 1000}},
+	}, nil
+}
+
+type testStorage struct {
+	invest.Storage
+	rules     []invest.AlertRule
+	events    []invest.AlertEvent
+	snapshots []invest.PortfolioSnapshot
+}
+
+func (s *testStorage) AlertRules(_ context.Context, provider, accountID string) ([]invest.AlertRule, error) {
+	var rules []invest.AlertRule
+	for _, r := range s.rules {
+		if accountID == "" || r.Provider == provider && r.AccountID == accountID {
+			rules = append(rules, r)
+		}
+	}
+	return rules, nil
+}
+
+func (s *testStorage) SaveAlertRule(_ context.Context, rule *invest.AlertRule) error {
+	if rule.ID == 0 {
+		rule.ID = int64(len(s.rules) + 1)
+		s.rules = append(s.rules, *rule)
+		return nil
+	}
+	for i, r := range s.rules {
+		if r.ID == rule.ID {
+			rule.CreatedAt = r.CreatedAt
+			s.rules[i] = *rule
+			return nil
+		}
+	}
+	return invest.ErrNotFound
+}
+
+func (s *testStorage) UpdateAlertState(_ context.Context, rule invest.AlertRule) error {
+	for i, r := range s.rules {
+		if r.ID == rule.ID {
+			s.rules[i].Active, s.rules[i].Peak, s.rules[i].TriggeredAt = rule.Active, rule.Peak, rule.TriggeredAt
+		}
+	}
+	return nil
+}
+
+func (s *testStorage) SaveAlertEvent(_ context.Context, event *invest.AlertEvent) error {
+	event.ID = int64(len(s.events) + 1)
+	s.events = append(s.events, *event)
+	return nil
+}
+
+func (s *testStorage) SavePortfolioSnapshot(_ context.Context, snapshot invest.PortfolioSnapshot) error {
+	s.snapshots = append(s.snapshots, snapshot)
+	return nil
+}
+
+func (s *testStorage) PortfolioSnapshot(_ context.Context, _, _ string, before time.Time) (invest.PortfolioSnapshot, error) {
+	var latest *invest.PortfolioSnapshot
+	for i, snapshot := range s.snapshots {
+		if snapshot.Date.Before(before) && (latest == nil || snapshot.Date.After(latest.Date)) {
+			latest = &s.snapshots[i]
+		}
+	}
+	if latest == nil {
+		return invest.PortfolioSnapshot{}, invest.ErrNotFound
+	}
+	return *latest, nil
+}
+
+func TestSaveRuleValidation(t *testing.T) {
+	account := &pb.Account{AccountId: "1", Provider: "tinkoff"}
+	tests := []struct {
+		name string
+		rule *pb.AlertRule
+		ok   bool
+	}{
+		{"nil", nil, false},
+		{"unspecified type", &pb.AlertRule{Figi: "F", Threshold: 1}, false},
+		{"price", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_PRICE_ABOVE, Figi: "F", Threshold: 1}, true},
+		{"price without figi", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_PRICE_BELOW, Threshold: 1}, false},
+		{"price without threshold", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_PRICE_BELOW, Figi: "F"}, false},
+		{"drawdown", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN, Account: account, Figi: "F", Threshold: 10}, true},
+		{"drawdown without account", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN, Figi: "F", Threshold: 10}, false},
+		{"drawdown of 100%", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN, Account: account, Figi: "F", Threshold: 100}, false},
+		{"daily change", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE, Account: account, Threshold: 5}, true},
+		{"daily change without account", &pb.AlertRule{Type: pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE, Threshold: 5}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := SaveRule(context.Background(), &testStorage{}, &pb.SaveAlertRuleRequest{Rule: tt.rule})
+			if tt.ok {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if resp.Rule.Id == 0 {
+					t.Error("rule id is not set")
+				}
+				return
+			}
+			if !errors.Is(err, invest.ErrInvalidArgument) {
+				t.Fatalf("want invalid argument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSaveRuleUpdateKeepsCreatedAt(t *testing.T) {
+	created := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	storage := &testStorage{rules: []invest.AlertRule{
+		{ID: 1, Type: pb.AlertType_ALERT_TYPE_PRICE_ABOVE, Figi: "F", Threshold: 1, CreatedAt: created, Active: true},
+	}}
+
+	resp, err := SaveRule(context.Background(), storage, &pb.SaveAlertRuleRequest{Rule: &pb.AlertRule{
+		Id: 1, Type: pb.AlertType_ALERT_TYPE_PRICE_ABOVE, Figi: "F", Threshold: 2, Enabled: true,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Rule.CreatedAt != created.Format(time.RFC3339) || resp.Rule.Threshold != 2 {
+		t.Errorf("unexpected rule %v", resp.Rule)
+	}
+}
+
+func TestEvaluatePrice(t *testing.T) {
+	provider := &testProvider{prices: map[string]float64{"F": 105}}
+	storage := &testStorage{rules: []invest.AlertRule{
+		{ID: 1, Type: pb.AlertType_ALERT_TYPE_PRICE_ABOVE, Figi: "F", Threshold: 100, Enabled: true},
+		{ID: 2, Type: pb.AlertType_ALERT_TYPE_PRICE_BELOW, Figi: "F", Threshold: 90, Enabled: true},
+		{ID: 3, Type: pb.AlertType_ALERT_TYPE_PRICE_ABOVE, Figi: "F", Threshold: 100},
+	}}
+	evaluator := newTestEvaluator(t, provider, storage, time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC))
+	notifier := &testNotifier{}
+	evaluator.notifier = notifier
+
+	events, err := evaluator.RunOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].RuleID != 1 || events[0].Value != 105 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if len(notifier.notifications) != 1 || notifier.notifications[0].Subject != "Alert: price above" ||
+		notifier.notifications[0].Text != "F price 105 RUB is above 100" {
+		t.Errorf("unexpected notifications %v", notifier.notifications)
+	}
+	if provider.quoteCalls != 1 {
+		t.Errorf("quote of instrument is loaded %d times", provider.quoteCalls)
+	}
+	if !storage.rules[0].Active || storage.rules[0].TriggeredAt.IsZero() {
+		t.Errorf("triggered rule state is not saved: %+v", storage.rules[0])
+	}
+
+	// condition still holds, rule does not trigger again
+	events, _ = evaluator.RunOnce(context.Background())
+	if len(events) != 0 {
+		t.Fatalf("active rule triggered again: %v", events)
+	}
+
+	// condition clears and holds again
+	provider.prices["F"] = 95
+	if events, _ = evaluator.RunOnce(context.Background()); len(events) != 0 || storage.rules[0].Active {
+		t.Fatalf("unexpected events %v, state %+v", events, storage.rules[0])
+	}
+	provider.prices["F"] = 101
+	if events, _ = evaluator.RunOnce(context.Background()); len(events) != 1 {
+		t.Fatalf("cleared rule did not trigger again: %v", events)
+	}
+	if len(storage.events) != 2 {
+		t.Errorf("want 2 stored events, got %d", len(storage.events))
+	}
+}
+
+func TestEvaluateDrawdown(t *testing.T) {
+	provider := &testProvider{
+		prices: map[string]float64{"F": 120},
+		positions: []*pb.Position{{
+			Figi:                 "F",
+			Ticker:               "TCKR",
+			Balance:              10,
+			AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 100},
+			ExpectedYield:        &pb.Yield{Currency: "RUB", Value: 200},
+		}},
+	}
+	storage := &testStorage{rules: []invest.AlertRule{
+		{ID: 1, Type: pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN, Provider: "tinkoff", AccountID: "1", Figi: "F", Threshold: 10, Enabled: true},
+	}}
+	evaluator := newTestEvaluator(t, provider, storage, time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC))
+
+	if events, _ := evaluator.RunOnce(context.Background()); len(events) != 0 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if storage.rules[0].Peak != 120 {
+		t.Fatalf("want peak 120, got %v", storage.rules[0].Peak)
+	}
+
+	// 12.5% below the peak although still above average price
+	provider.prices["F"] = 105
+	events, err := evaluator.RunOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Value != 12.5 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if storage.rules[0].Peak != 120 {
+		t.Errorf("peak changed to %v", storage.rules[0].Peak)
+	}
+}
+
+func TestEvaluateDailyChange(t *testing.T) {
+	provider := &testProvider{positions: []*pb.Position{{
+		Figi:                 "F",
+		Balance:              10,
+		AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 100},
+		ExpectedYield:        &pb.Yield{Currency: "RUB", Value: 0},
+	}}}
+	storage := &testStorage{rules: []invest.AlertRule{
+		{ID: 1, Type: pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE, Provider: "tinkoff", AccountID: "1", Threshold: 5, Enabled: true},
+		{ID: 2, Type: pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE, Provider: "tinkoff", AccountID: "1", Threshold: 20, Enabled: true},
+	}}
+	day := time.Date(2021, 3, 1, 18, 0, 0, 0, time.UTC)
+	evaluator := newTestEvaluator(t, provider, storage, day)
+
+	// the first day has nothing to compare with
+	if events, _ := evaluator.RunOnce(context.Background()); len(events) != 0 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if len(storage.snapshots) != 1 || storage.snapshots[0].Value != 2000 {
+		t.Fatalf("unexpected snapshots %v", storage.snapshots)
+	}
+	if provider.portfolioCalls != 1 {
+		t.Errorf("portfolio is loaded %d times", provider.portfolioCalls)
+	}
+
+	// portfolio loses 10% by the next day
+	provider.positions[0].ExpectedYield.Value = -200
+	evaluator.now = func() time.Time { return day.Add(16 * time.Hour) }
+	events, err := evaluator.RunOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].RuleID != 1 || events[0].Value != -10 {
+		t.Fatalf("unexpected events %v", events)
+	}
+}
+
+type testNotifier struct {
+	notifications []invest.Notification
+}
+
+func (n *testNotifier) Notify(_ context.Context, notification invest.Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func newTestEvaluator(t *testing.T, provider invest.Provider, storage invest.Storage, now time.Time) *Evaluator {
+	t.Helper()
+	evaluator, err := NewEvaluator(Config{}, provider, storage, nil, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	evaluator.now = func() time.Time { return now }
+	return evaluator
+}
+
+// countingStorage counts evaluations by loads of rules.
+type countingStorage struct {
+	invest.Storage
+	loads chan struct{}
+}
+
+func (s *countingStorage) AlertRules(context.Context, string, string) ([]invest.AlertRule, error) {
+	s.loads <- struct{}{}
+	return nil, nil
+}
+
+func TestReconfigure(t *testing.T) {
+
+	storage := &countingStorage{loads: make(chan struct{}, 100)}
+	evaluator, err := NewEvaluator(Config{Interval: time.Hour}, &testProvider{}, storage, nil, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- evaluator.Run(ctx) }()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	<-storage.loads
+	// hourly ticker is restarted with the new interval
+	evaluator.Reconfigure(Config{Interval: 10 * time.Millisecond, CurrencyRates: map[string]float64{"USD": 90}})
+	for i := 0; i < 2; i++ {
+		select {
+		case <-storage.loads:
+		case <-time.After(5 * time.Second):
+			t.Fatal("rules are not evaluated with the new interval")
+		}
+	}
+	if conf := evaluator.config(); conf.CurrencyRates["USD"] != 90 {
+		t.Errorf("config = %+v", conf)
+	}
+}
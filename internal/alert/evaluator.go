@@ -0,0 +1,385 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/allocation"
+	"goinvest/internal/invest"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultInterval is how often evaluator checks alert rules.
+const defaultInterval = 5 * time.Minute
+
+// Config configures background evaluation of alert rules.
+type Config struct {
+	Enabled bool `yaml:"enabled"`
+	// Interval between evaluations, five minutes by default.
+	Interval time.Duration `yaml:"interval" validate:"gte=0"`
+	// CurrencyRates are rouble rates of currencies used to value portfolios for daily change rules.
+	CurrencyRates map[string]float64 `yaml:"currencyRates"`
+}
+
+// Evaluator periodically checks enabled alert rules against provider quotes and portfolios.
+// Rule triggers when its condition starts to hold, it triggers again only after condition is cleared.
+type Evaluator struct {
+	mu           sync.Mutex
+	conf         Config
+	reconfigured chan struct{}
+
+	provider invest.Provider
+	storage  invest.Storage
+	notifier invest.Notifier
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+// NewEvaluator creates evaluator, triggered alerts are delivered with notifier unless it is nil.
+func NewEvaluator(conf Config, provider invest.Provider, storage invest.Storage, notifier invest.Notifier,
+	logger *zap.Logger) (*Evaluator, error) {
+
+	if provider == nil {
+		return nil, errors.New("provider provided to alert evaluator is nil")
+	}
+	if storage == nil {
+		return nil, errors.New("storage provided to alert evaluator is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger provided to alert evaluator is nil")
+	}
+
+	if conf.Interval <= 0 {
+		conf.Interval = defaultInterval
+	}
+
+	return &Evaluator{
+		conf:         conf,
+		reconfigured: make(chan struct{}, 1),
+		provider:     provider,
+		storage:      storage,
+		notifier:     notifier,
+		logger:       logger.With(zap.String("service", "alerts")),
+		now:          time.Now,
+	}, nil
+}
+
+// Reconfigure applies interval and currency rates of reloaded config, the next evaluation uses them.
+// Enabled flag is not applied, evaluator is started or stopped on restart only.
+func (e *Evaluator) Reconfigure(conf Config) {
+
+	if conf.Interval <= 0 {
+		conf.Interval = defaultInterval
+	}
+	e.mu.Lock()
+	e.conf = conf
+	e.mu.Unlock()
+
+	select {
+	case e.reconfigured <- struct{}{}:
+	default:
+		// Run has not handled the previous change yet, it reads the latest config anyway
+	}
+}
+
+func (e *Evaluator) config() Config {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.conf
+}
+
+// Run evaluates rules immediately and then every interval until context is done.
+func (e *Evaluator) Run(ctx context.Context) error {
+
+	ticker := time.NewTicker(e.config().Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := e.RunOnce(ctx); err != nil {
+			e.logger.Error("problem while evaluating alert rules", zap.Error(err))
+		}
+		if !e.wait(ctx, ticker) {
+			return nil
+		}
+	}
+}
+
+// wait waits for the next tick restarting ticker when interval changes, it returns false when context is done.
+func (e *Evaluator) wait(ctx context.Context, ticker *time.Ticker) bool {
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			return true
+		case <-e.reconfigured:
+			ticker.Reset(e.config().Interval)
+		}
+	}
+}
+
+// RunOnce evaluates enabled rules and returns triggered alerts. Quotes and portfolios are loaded once
+// per evaluation, failure of a rule is logged and does not stop others.
+func (e *Evaluator) RunOnce(ctx context.Context) ([]invest.AlertEvent, error) {
+
+	rules, err := e.storage.AlertRules(ctx, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("load alert rules: %w", err)
+	}
+
+	var (
+		now        = e.now().UTC().Truncate(time.Second)
+		rates      = invest.RatesFromConfig(e.config().CurrencyRates)
+		quotes     = make(map[string]*pb.Quote)
+		portfolios = make(map[[2]string]*pb.PortfolioResponse)
+		snapshots  = make(map[[2]string]float64)
+		events     []invest.AlertEvent
+		// notifications are delivered after evaluation, so slow channels do not delay rules
+		notifications []invest.Notification
+	)
+
+	quote := func(figi string) (*pb.Quote, error) {
+		if q, found := quotes[figi]; found {
+			return q, nil
+		}
+		resp, err := e.provider.Quote(ctx, &pb.QuoteRequest{Figi: figi})
+		if err != nil {
+			return nil, fmt.Errorf("load quote of %s: %w", figi, err)
+		}
+		quotes[figi] = resp.Quote
+		return resp.Quote, nil
+	}
+	portfolio := func(rule invest.AlertRule) (*pb.PortfolioResponse, error) {
+		key := [2]string{rule.Provider, rule.AccountID}
+		if p, found := portfolios[key]; found {
+			return p, nil
+		}
+		p, err := e.provider.Portfolio(ctx, &pb.PortfolioRequest{
+			Account: &pb.Account{Provider: rule.Provider, AccountId: rule.AccountID},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("load portfolio of account %s: %w", rule.AccountID, err)
+		}
+		portfolios[key] = p
+		return p, nil
+	}
+	// portfolio value is snapshotted once per account, so every daily change rule compares the same values
+	value := func(rule invest.AlertRule) (float64, error) {
+		key := [2]string{rule.Provider, rule.AccountID}
+		if v, found := snapshots[key]; found {
+			return v, nil
+		}
+		p, err := portfolio(rule)
+		if err != nil {
+			return 0, err
+		}
+		analysis, err := allocation.Analyze([]*pb.PortfolioResponse{p}, nil, rates)
+		if err != nil {
+			return 0, fmt.Errorf("value portfolio of account %s: %w", rule.AccountID, err)
+		}
+		err = e.storage.SavePortfolioSnapshot(ctx, invest.PortfolioSnapshot{
+			Provider:  rule.Provider,
+			AccountID: rule.AccountID,
+			Date:      now,
+			Value:     analysis.Total,
+		})
+		if err != nil {
+			return 0, err
+		}
+		snapshots[key] = analysis.Total
+		return analysis.Total, nil
+	}
+
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		logger := e.logger.With(zap.Int64("rule", rule.ID), zap.String("type", rule.Type.String()))
+
+		var (
+			state = rule
+			check condition
+			err   error
+		)
+		switch rule.Type {
+		case pb.AlertType_ALERT_TYPE_PRICE_ABOVE, pb.AlertType_ALERT_TYPE_PRICE_BELOW:
+			check, err = e.checkPrice(rule, quote)
+		case pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN:
+			check, err = e.checkDrawdown(rule, portfolio, quote)
+			state.Peak = check.peak
+		case pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE:
+			check, err = e.checkDailyChange(ctx, rule, now, value)
+		default:
+			err = fmt.Errorf("unknown alert type %s", rule.Type)
+		}
+		if err != nil {
+			logger.Error("problem while evaluating alert rule", zap.Error(err))
+			continue
+		}
+
+		state.Active = check.holds
+		if check.holds && !rule.Active {
+			event := invest.AlertEvent{
+				RuleID:      rule.ID,
+				Type:        rule.Type,
+				Provider:    rule.Provider,
+				AccountID:   rule.AccountID,
+				Figi:        rule.Figi,
+				Threshold:   rule.Threshold,
+				Value:       check.value,
+				Message:     check.message,
+				TriggeredAt: now,
+			}
+			if err := e.storage.SaveAlertEvent(ctx, &event); err != nil {
+				logger.Error("problem while saving alert event", zap.Error(err))
+				continue
+			}
+			state.TriggeredAt = now
+			events = append(events, event)
+			notifications = append(notifications, notification(rule, event))
+			logger.Info("alert triggered", zap.String("message", event.Message))
+		}
+
+		if state.Active != rule.Active || state.Peak != rule.Peak || !state.TriggeredAt.Equal(rule.TriggeredAt) {
+			if err := e.storage.UpdateAlertState(ctx, state); err != nil {
+				logger.Error("problem while updating alert rule state", zap.Error(err))
+			}
+		}
+	}
+
+	if e.notifier != nil {
+		for _, n := range notifications {
+			if err := e.notifier.Notify(ctx, n); err != nil {
+				e.logger.Error("problem while notifying about alert", zap.Error(err))
+			}
+		}
+	}
+
+	return events, nil
+}
+
+// condition is a result of rule evaluation.
+type condition struct {
+	holds   bool
+	value   float64
+	message string
+	// peak is the updated peak price of drawdown rule.
+	peak float64
+}
+
+func (e *Evaluator) checkPrice(rule invest.AlertRule, quote func(string) (*pb.Quote, error)) (condition, error) {
+
+	q, err := quote(rule.Figi)
+	if err != nil {
+		return condition{}, err
+	}
+
+	c := condition{value: q.Price}
+	if rule.Type == pb.AlertType_ALERT_TYPE_PRICE_ABOVE {
+		c.holds = q.Price >= rule.Threshold
+		c.message = fmt.Sprintf("%s price %g %s is above %g", instrumentName(q.Ticker, rule.Figi), q.Price, q.Currency, rule.Threshold)
+	} else {
+		c.holds = q.Price <= rule.Threshold
+		c.message = fmt.Sprintf("%s price %g %s is below %g", instrumentName(q.Ticker, rule.Figi), q.Price, q.Currency, rule.Threshold)
+	}
+	return c, nil
+}
+
+// checkDrawdown measures fall of instrument price from the highest price seen since rule was saved,
+// average position price is the initial peak so drawdown below purchase price is reported too.
+func (e *Evaluator) checkDrawdown(rule invest.AlertRule, portfolio func(invest.AlertRule) (*pb.PortfolioResponse, error),
+	quote func(string) (*pb.Quote, error)) (condition, error) {
+
+	p, err := portfolio(rule)
+	if err != nil {
+		return condition{}, err
+	}
+	var position *pb.Position
+	for _, pos := range p.Positions {
+		if pos.Figi == rule.Figi {
+			position = pos
+			break
+		}
+	}
+	// closed position has nothing to draw down, peak is reset for the next one
+	if position == nil || position.Balance == 0 {
+		return condition{}, nil
+	}
+
+	price, _ := invest.PositionPrice(position)
+	ticker := position.Ticker
+	if q, err := quote(rule.Figi); err == nil && q.Price > 0 {
+		price = q.Price
+	}
+
+	peak := math.Max(rule.Peak, math.Max(position.AveragePositionPrice.GetValue(), price))
+	if peak <= 0 {
+		return condition{peak: rule.Peak}, nil
+	}
+	drawdown := (peak - price) / peak * 100
+
+	return condition{
+		holds: drawdown >= rule.Threshold,
+		value: drawdown,
+		message: fmt.Sprintf("%s in account %s fell %.2f%% from %g to %g", instrumentName(ticker, rule.Figi),
+			rule.AccountID, drawdown, peak, price),
+		peak: peak,
+	}, nil
+}
+
+// checkDailyChange compares current portfolio value in roubles with the last value stored before today.
+func (e *Evaluator) checkDailyChange(ctx context.Context, rule invest.AlertRule, now time.Time,
+	value func(invest.AlertRule) (float64, error)) (condition, error) {
+
+	current, err := value(rule)
+	if err != nil {
+		return condition{}, err
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	previous, err := e.storage.PortfolioSnapshot(ctx, rule.Provider, rule.AccountID, today)
+	// the first day of observation has nothing to compare with
+	if errors.Is(err, invest.ErrNotFound) {
+		return condition{}, nil
+	}
+	if err != nil {
+		return condition{}, err
+	}
+	if previous.Value == 0 {
+		return condition{}, nil
+	}
+
+	change := (current - previous.Value) / previous.Value * 100
+	return condition{
+		holds: math.Abs(change) >= rule.Threshold,
+		value: change,
+		message: fmt.Sprintf("portfolio of account %s changed %+.2f%% since %s, from %.2f to %.2f RUB", rule.AccountID,
+			change, previous.Date.Format("2006-01-02"), previous.Value, current),
+	}, nil
+}
+
+func notification(rule invest.AlertRule, event invest.AlertEvent) invest.Notification {
+	subject := rule.Name
+	if subject == "" {
+		subject = strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(rule.Type.String(), "ALERT_TYPE_"), "_", " "))
+	}
+	return invest.Notification{
+		Kind:    invest.NotificationAlert,
+		Subject: "Alert: " + subject,
+		Text:    event.Message,
+		Data:    toPbEvent(event),
+	}
+}
+
+func instrumentName(ticker, figi string) string {
+	if ticker != "" {
+		return ticker
+	}
+	return figi
+}
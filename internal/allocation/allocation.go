@@ -0,0 +1,198 @@
+package allocation
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"golang.org/x/sync/errgroup"
+	"sort"
+	"strings"
+)
+
+const (
+	unknown = "Unknown"
+	cash    = "Cash"
+)
+
+// Allocation loads portfolio of the requested account, or of all provider accounts when account is not set,
+// and returns its weights enriched with instrument metadata from storage.
+func Allocation(ctx context.Context, provider invest.Provider, storage invest.InstrumentStorage, req *pb.AllocationRequest) (*pb.AllocationResponse, error) {
+
+	accounts := []*pb.Account{req.Account}
+	if req.Account == nil || req.Account.AccountId == "" {
+		accountsResponse, err := provider.Accounts(ctx, &pb.AccountsRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("load accounts: %w", err)
+		}
+		accounts = accountsResponse.Accounts
+	}
+
+	portfolios := make([]*pb.PortfolioResponse, len(accounts))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, account := range accounts {
+		i, account := i, account
+		g.Go(func() error {
+			portfolio, err := provider.Portfolio(gctx, &pb.PortfolioRequest{Account: account})
+			if err != nil {
+				return fmt.Errorf("load portfolio of account %s: %w", account.AccountId, err)
+			}
+			portfolios[i] = portfolio
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var figis []string
+	for _, portfolio := range portfolios {
+		for _, position := range portfolio.Positions {
+			figis = append(figis, position.Figi)
+		}
+	}
+	instruments, err := storage.Instruments(ctx, figis)
+	if err != nil {
+		return nil, fmt.Errorf("load instruments: %w", err)
+	}
+
+	return Analyze(portfolios, instruments, invest.NewRates(req.CurrencyRates))
+}
+
+// Analyze calculates weights of portfolios positions and cash in roubles grouped by instrument type,
+// currency, sector, country and issuer. Missing sector is reported as unknown, missing issuer falls back
+// to instrument name and missing country is taken from ISIN prefix.
+func Analyze(portfolios []*pb.PortfolioResponse, instruments map[string]invest.Instrument, rates invest.Rates) (*pb.AllocationResponse, error) {
+
+	var (
+		total        float64
+		byType       = make(map[string]float64)
+		byCurrency   = make(map[string]float64)
+		bySector     = make(map[string]float64)
+		byCountry    = make(map[string]float64)
+		byIssuer     = make(map[string]float64)
+		addAllocated = func(value float64, instrumentType, currency, sector, country, issuer string) {
+			total += value
+			byType[instrumentType] += value
+			byCurrency[currency] += value
+			bySector[sector] += value
+			byCountry[country] += value
+			byIssuer[issuer] += value
+		}
+	)
+
+	for _, portfolio := range portfolios {
+
+		for _, position := range portfolio.Positions {
+			// currency positions are accounted by portfolio currencies
+			if position.InstrumentType == invest.InstrumentTypeCurrency {
+				continue
+			}
+
+			value, currency := invest.PositionValue(position)
+			value, err := rates.ToRUB(value, currency)
+			if err != nil {
+				return nil, err
+			}
+
+			instrument := instruments[position.Figi]
+			addAllocated(value,
+				orUnknown(position.InstrumentType),
+				orUnknown(currency),
+				orUnknown(instrument.Sector),
+				orUnknown(country(instrument, position.Isin)),
+				orUnknown(firstNonEmpty(instrument.Issuer, instrument.Name, position.Name, position.Ticker)),
+			)
+		}
+
+		for _, balance := range portfolio.Currencies {
+			value, err := rates.ToRUB(balance.Balance, balance.Currency)
+			if err != nil {
+				return nil, err
+			}
+			addAllocated(value, cash, orUnknown(balance.Currency), cash, unknown, cash)
+		}
+	}
+
+	return &pb.AllocationResponse{
+		Total:            total,
+		ByInstrumentType: weights(byType, total),
+		ByCurrency:       weights(byCurrency, total),
+		BySector:         weights(bySector, total),
+		ByCountry:        weights(byCountry, total),
+		ByIssuer:         weights(byIssuer, total),
+	}, nil
+}
+
+// weights converts grouped values to weights sorted by value descending.
+func weights(values map[string]float64, total float64) []*pb.AllocationWeight {
+	result := make([]*pb.AllocationWeight, 0, len(values))
+	for key, value := range values {
+		weight := &pb.AllocationWeight{Key: key, Value: value}
+		if total != 0 {
+			weight.Weight = value / total
+		}
+		result = append(result, weight)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Value == result[j].Value {
+			return result[i].Key < result[j].Key
+		}
+		return result[i].Value > result[j].Value
+	})
+	return result
+}
+
+// country returns instrument country, falling back to ISIN country prefix.
+func country(instrument invest.Instrument, isin string) string {
+	if instrument.Country != "" {
+		return instrument.Country
+	}
+	if isin == "" {
+		isin = instrument.Isin
+	}
+	if len(isin) >= 2 {
+		return strings.ToUpper(isin[:2])
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func orUnknown(value string) string {
+	if value == "" {
+		return unknown
+	}
+	return value
+}
+
+// SaveInstrument stores instrument metadata used to group positions by sector, country and issuer.
+func SaveInstrument(ctx context.Context, storage invest.InstrumentStorage, req *pb.SaveInstrumentRequest) (*pb.SaveInstrumentResponse, error) {
+
+	instrument := req.Instrument
+	if instrument == nil || instrument.Figi == "" {
+		return nil, fmt.Errorf("%w: instrument figi is required", invest.ErrInvalidArgument)
+	}
+
+	err := storage.SaveInstrument(ctx, invest.Instrument{
+		Figi:    instrument.Figi,
+		Ticker:  instrument.Ticker,
+		Isin:    instrument.Isin,
+		Name:    instrument.Name,
+		Sector:  instrument.Sector,
+		Country: instrument.Country,
+		Issuer:  instrument.Issuer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SaveInstrumentResponse{}, nil
+}
@@ -0,0 +1,101 @@
+package allocation
+
+import (
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"math"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+
+	portfolios := []*pb.PortfolioResponse{
+		{
+			Positions: []*pb.Position{
+				{
+					Figi:                 "BBG000B9XRY4",
+					Isin:                 "US0378331005",
+					Name:                 "Apple",
+					InstrumentType:       "Stock",
+					Balance:              2,
+					AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 100},
+					ExpectedYield:        &pb.Yield{Currency: "USD", Value: 50},
+				},
+				{
+					Figi:                 "BBG0013HGFT4",
+					InstrumentType:       invest.InstrumentTypeCurrency,
+					Balance:              10,
+					AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 70},
+				},
+			},
+			Currencies: []*pb.CurrencyBalance{{Currency: "USD", Balance: 10}},
+		},
+		{
+			Positions: []*pb.Position{
+				{
+					Figi:                 "BBG004730N88",
+					Isin:                 "RU0009029540",
+					Name:                 "Сбер Банк",
+					InstrumentType:       "Stock",
+					Balance:              10,
+					AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 1050},
+				},
+			},
+			Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 4000}},
+		},
+	}
+	instruments := map[string]invest.Instrument{
+		"BBG004730N88": {Figi: "BBG004730N88", Sector: "Financials", Issuer: "Sberbank"},
+	}
+
+	res, err := Analyze(portfolios, instruments, invest.Rates{"USD": 70})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// apple 250$ = 17500, cash 10$ = 700, sber 10500, cash 4000
+	if res.Total != 32700 {
+		t.Fatalf("unexpected total %v", res.Total)
+	}
+
+	find := func(weights []*pb.AllocationWeight, key string) float64 {
+		for _, w := range weights {
+			if w.Key == key {
+				return w.Value
+			}
+		}
+		return math.NaN()
+	}
+
+	checks := []struct {
+		name     string
+		weights  []*pb.AllocationWeight
+		key      string
+		expected float64
+	}{
+		{"type stock", res.ByInstrumentType, "Stock", 28000},
+		{"type cash", res.ByInstrumentType, cash, 4700},
+		{"currency usd", res.ByCurrency, "USD", 18200},
+		{"sector financials", res.BySector, "Financials", 10500},
+		{"sector unknown", res.BySector, unknown, 17500},
+		{"country from isin", res.ByCountry, "US", 17500},
+		{"issuer from metadata", res.ByIssuer, "Sberbank", 10500},
+		{"issuer from name", res.ByIssuer, "Apple", 17500},
+	}
+	for _, c := range checks {
+		if got := find(c.weights, c.key); got != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, got)
+		}
+	}
+
+	if res.ByInstrumentType[0].Key != "Stock" || math.Abs(res.ByInstrumentType[0].Weight-28000.0/32700) > 1e-9 {
+		t.Errorf("weights are not sorted by value: %v", res.ByInstrumentType)
+	}
+}
+
+func TestAnalyzeMissingRate(t *testing.T) {
+	portfolios := []*pb.PortfolioResponse{{Currencies: []*pb.CurrencyBalance{{Currency: "EUR", Balance: 1}}}}
+	if _, err := Analyze(portfolios, nil, nil); err == nil {
+		t.Error("expected error for missing currency rate")
+	}
+}
@@ -14,7 +14,7 @@ import (
 	vault "github.com/hashicorp/vault/api"
 	"go.uber.org/zap"
 
-	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	_ "github.com/spf13/viper/remote"
@@ -39,7 +39,7 @@ type Options struct {
 	Type               string // yaml or json. Set to yaml if empty
 	DevFile            string // dev config file name, set to dev.(yaml|json) if empty
 	ProdFile           string // prod config file name, set to main.(yaml|json) if empty
-	ReplaceFromEnvVars bool   // replace config values from ENV VARS, false by default
+	ReplaceFromEnvVars bool   // replace config values from ENV VARS, see EnvVar, false by default
 	EnvVarsPrefix      string // prefix for ENV VARS, empty by default
 }
 
@@ -64,65 +64,81 @@ func (o *Options) fill() error {
 	}
 	return nil
 }
+
+// Parse fills configStruct from file, Consul and Vault, overrides its fields from environment variables when
+// enabled and validates it by `validate` struct tags. Keys of config are taken from `yaml` struct tags.
+// All invalid fields are reported at once with *ValidationError.
 func Parse(configStruct interface{}, opts Options, log *zap.Logger) error {
+	_, err := parse(configStruct, opts, log)
+	return err
+}
+
+// sources are sources config is loaded from, they are watched for changes by Watcher.
+type sources struct {
+	file      string // absolute path of config file, empty when it is not loaded
+	consulKey string // empty when config is not loaded from Consul
+}
+
+func parse(configStruct interface{}, opts Options, log *zap.Logger) (sources, error) {
 	if log == nil {
 		log = zap.NewNop()
 	}
 
 	t := reflect.TypeOf(configStruct)
 	if t.Kind() != reflect.Ptr {
-		return errors.New("configStruct arg must be pointer")
+		return sources{}, errors.New("configStruct arg must be pointer")
 	}
 	if t.Elem().Kind() != reflect.Struct {
-		return errors.New("configStruct arg must be pointer to struct")
+		return sources{}, errors.New("configStruct arg must be pointer to struct")
 	}
 
 	err := opts.fill()
 	if err != nil {
-		return err
+		return sources{}, err
 	}
-	loadedFromFile, err := loadFromFile(log, opts)
+	v := viper.New()
+	var src sources
+	src.file, err = loadFromFile(v, log, opts)
 	if err != nil {
-		return err
+		return sources{}, err
 	}
 
-	loadedFromConsul, err := loadFromConsul(log, opts)
+	src.consulKey, err = loadFromConsul(v, log, opts)
 	if err != nil {
-		return err
+		return sources{}, err
 	}
 
-	loadedFromVault, err := loadFromVault(log)
+	loadedFromVault, err := loadFromVault(v, log)
 	if err != nil {
-		return err
+		return sources{}, err
 	}
 
-	if !loadedFromFile && !loadedFromConsul && !loadedFromVault {
-		return errors.New("cannot load from config, please set at least one of sources: file, consul, vault")
+	if src.file == "" && src.consulKey == "" && !loadedFromVault {
+		return sources{}, errors.New("cannot load from config, please set at least one of sources: file, consul, vault")
 	}
 
 	if opts.ReplaceFromEnvVars {
-		viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
-		viper.AllowEmptyEnv(true)
-		viper.SetEnvPrefix(opts.EnvVarsPrefix)
-		viper.AutomaticEnv()
-	}
-
-	err = viper.Unmarshal(configStruct)
-	if err != nil {
-		return err
+		// keys missing in config sources are overridden as well, so every field is bound explicitly
+		v.AllowEmptyEnv(true)
+		for _, key := range Keys(configStruct) {
+			if err := v.BindEnv(key, EnvVar(opts.EnvVarsPrefix, key)); err != nil {
+				return sources{}, err
+			}
+		}
 	}
 
-	// some checks
-	validate := validator.New()
-	err = validate.Struct(configStruct)
+	err = v.Unmarshal(configStruct, func(dc *mapstructure.DecoderConfig) {
+		dc.TagName = "yaml"
+	})
 	if err != nil {
-		return err
+		return sources{}, err
 	}
-	return nil
 
+	return src, Validate(configStruct)
 }
 
-func loadFromFile(log *zap.Logger, opts Options) (loaded bool, err error) {
+// loadFromFile reads dev or prod file and returns its absolute path, path is empty when neither exists.
+func loadFromFile(v *viper.Viper, log *zap.Logger, opts Options) (path string, err error) {
 	configPath := opts.Dir + opts.DevFile
 	if err := fileExists(configPath); err != nil {
 		log.Debug("dev file not exists", zap.String("path", configPath), zap.Error(err))
@@ -131,67 +147,79 @@ func loadFromFile(log *zap.Logger, opts Options) (loaded bool, err error) {
 
 	if err := fileExists(configPath); err != nil {
 		log.Debug("prod file not exists", zap.String("path", configPath), zap.Error(err))
-		return false, nil
+		return "", nil
 	}
 
 	log.Info("load config from file", zap.String("path", configPath))
 	configPath, err = filepath.Abs(configPath)
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	viper.SetConfigFile(configPath)
-	viper.SetConfigType(opts.Type)
+	v.SetConfigFile(configPath)
+	v.SetConfigType(opts.Type)
 	//fmt.Printf("start parsing config file %s, env prefix %s\n", configPath, prefix)
-	err = viper.ReadInConfig()
+	err = v.ReadInConfig()
 	if err != nil {
-		return false, err
+		return "", err
 	}
 	log.Info("done loading config from file")
-	return true, nil
+	return configPath, nil
 }
 
-func loadFromConsul(log *zap.Logger, opts Options) (loaded bool, err error) {
+// consulKV returns Consul KV client and key of config, key is empty when Consul is not configured.
+func consulKV() (*consul.KV, string, error) {
 	if os.Getenv(consulHttpAddr) == "" || os.Getenv(pathApp) == "" || os.Getenv(configPathKey) == "" {
-		log.Info("skip loading from consul, empty env variable(s)")
-		return false, nil
+		return nil, "", nil
 	}
-	log.Info("loading from consul", zap.String("addr", os.Getenv(consulHttpAddr)),
-		zap.String("path", os.Getenv(pathApp)), zap.String("key", os.Getenv(configPathKey)))
 	consulConfig := consul.DefaultConfig()
 	consulConfig.WaitTime = 10 * time.Second
 	client, err := consul.NewClient(consulConfig)
 	if err != nil {
-		return false, err
+		return nil, "", err
 	}
-	kv := client.KV()
-	kvp, _, err := kv.Get(os.Getenv(pathApp)+"/"+os.Getenv(configPathKey), nil)
+	return client.KV(), os.Getenv(pathApp) + "/" + os.Getenv(configPathKey), nil
+}
+
+// loadFromConsul merges config from Consul KV and returns its key, key is empty when Consul is not configured.
+func loadFromConsul(v *viper.Viper, log *zap.Logger, opts Options) (key string, err error) {
+	kv, key, err := consulKV()
 	if err != nil {
-		return false, err
+		return "", err
+	}
+	if key == "" {
+		log.Info("skip loading from consul, empty env variable(s)")
+		return "", nil
+	}
+	log.Info("loading from consul", zap.String("addr", os.Getenv(consulHttpAddr)),
+		zap.String("path", os.Getenv(pathApp)), zap.String("key", os.Getenv(configPathKey)))
+	kvp, _, err := kv.Get(key, nil)
+	if err != nil {
+		return "", err
 	}
 
 	if kvp == nil {
-		return false, errors.Errorf("no data at path %s key %s", os.Getenv(pathApp), os.Getenv(configPathKey))
+		return "", errors.Errorf("no data at path %s key %s", os.Getenv(pathApp), os.Getenv(configPathKey))
 	}
 	viperConfig := make(map[string]interface{})
 
 	switch opts.Type {
 	case "json":
 		if err = json.Unmarshal(kvp.Value, &viperConfig); err != nil {
-			return false, err
+			return "", err
 		}
 	case "yaml":
 		if err = yaml.Unmarshal(kvp.Value, &viperConfig); err != nil {
-			return false, err
+			return "", err
 		}
 	}
-	if err = viper.MergeConfigMap(viperConfig); err != nil {
-		return false, err
+	if err = v.MergeConfigMap(viperConfig); err != nil {
+		return "", err
 	}
 	log.Info("done loading from consul")
 
-	return true, nil
+	return key, nil
 }
-func loadFromVault(log *zap.Logger) (loaded bool, err error) {
+func loadFromVault(v *viper.Viper, log *zap.Logger) (loaded bool, err error) {
 	if os.Getenv(vaultAddr) == "" {
 		log.Info("skip loading from vault")
 		return false, nil
@@ -218,7 +246,7 @@ func loadFromVault(log *zap.Logger) (loaded bool, err error) {
 		log.Warn("path not exists", zap.String("path", os.Getenv(pathApp)+"/data/"+os.Getenv(configPathKey)))
 		return false, nil
 	}
-	err = viper.MergeConfigMap(data.Data["data"].(map[string]interface{}))
+	err = v.MergeConfigMap(data.Data["data"].(map[string]interface{}))
 	if err != nil {
 		return false, err
 	}
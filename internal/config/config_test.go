@@ -0,0 +1,222 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testConfig struct {
+	Server struct {
+		Port    string        `yaml:"port" validate:"required"`
+		Timeout time.Duration `yaml:"timeout" validate:"gte=0"`
+		Origins []string      `yaml:"origins"`
+	} `yaml:"server"`
+	Database struct {
+		Host     string `yaml:"host" validate:"required"`
+		Password string `yaml:"password" secret:"true"`
+	} `yaml:"database"`
+	Tracing struct {
+		Enabled     bool              `yaml:"enabled"`
+		Endpoint    string            `yaml:"endpoint" validate:"required_if=Enabled true,omitempty,url"`
+		SampleRatio float64           `yaml:"sampleRatio" validate:"gte=0,lte=1"`
+		Headers     map[string]string `yaml:"headers" secret:"true"`
+	} `yaml:"tracing"`
+	Providers []struct {
+		Name string `yaml:"name" validate:"required"`
+	} `yaml:"providers" validate:"dive"`
+	Ignored string `yaml:"-"`
+}
+
+const testFile = `
+server:
+  port: "8080"
+  timeout: 5s
+database:
+  host: localhost
+tracing:
+  headers:
+    authorization: Bearer token
+providers:
+  - name: fake
+`
+
+// writeConfig writes main.yaml to a new directory and disables other config sources.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	for _, env := range []string{consulHttpAddr, pathApp, configPathKey, vaultAddr} {
+		t.Setenv(env, "")
+	}
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.yaml"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func parseFile(t *testing.T, content string) (*testConfig, error) {
+	t.Helper()
+
+	conf := &testConfig{}
+	err := Parse(conf, Options{Dir: writeConfig(t, content), ReplaceFromEnvVars: true, EnvVarsPrefix: "test"}, nil)
+	return conf, err
+}
+
+func TestParse(t *testing.T) {
+
+	// keys missing in file are overridden as well
+	t.Setenv("TEST_SERVER_TIMEOUT", "1m")
+	t.Setenv("TEST_SERVER_ORIGINS", "https://a.example.com,https://b.example.com")
+	t.Setenv("TEST_DATABASE_PASSWORD", "secret")
+	t.Setenv("TEST_TRACING_SAMPLERATIO", "0.5")
+
+	conf, err := parseFile(t, testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf.Server.Port != "8080" || conf.Database.Host != "localhost" || conf.Providers[0].Name != "fake" ||
+		conf.Tracing.Headers["authorization"] != "Bearer token" {
+		t.Errorf("file values = %+v", conf)
+	}
+	if conf.Server.Timeout != time.Minute || conf.Database.Password != "secret" || conf.Tracing.SampleRatio != 0.5 ||
+		!reflect.DeepEqual(conf.Server.Origins, []string{"https://a.example.com", "https://b.example.com"}) {
+		t.Errorf("env values = %+v", conf)
+	}
+}
+
+func TestParseValidation(t *testing.T) {
+
+	_, err := parseFile(t, `
+server:
+  timeout: -1s
+tracing:
+  enabled: true
+  sampleRatio: 2
+providers:
+  - name: ""
+`)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("error = %v, want validation error", err)
+	}
+	want := []FieldError{
+		{"server.port", "is required"},
+		{"server.timeout", "must be at least 0, got -1s"},
+		{"database.host", "is required"},
+		{"tracing.endpoint", "is required when Enabled is true"},
+		{"tracing.sampleRatio", "must be at most 1, got 2"},
+		{"providers[0].name", "is required"},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Errorf("fields = %+v, want %+v", validationErr.Fields, want)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "server.port is required; server.timeout must be at least 0") {
+		t.Errorf("message = %s", msg)
+	}
+}
+
+func TestKeys(t *testing.T) {
+
+	want := []string{"server.port", "server.timeout", "server.origins", "database.host", "database.password",
+		"tracing.enabled", "tracing.endpoint", "tracing.sampleRatio"}
+	if keys := Keys(&testConfig{}); !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys() = %v, want %v", keys, want)
+	}
+	if name := EnvVar("invest", "server.tls.certFile"); name != "INVEST_SERVER_TLS_CERTFILE" {
+		t.Errorf("EnvVar() = %s", name)
+	}
+}
+
+func TestPrint(t *testing.T) {
+
+	conf := &testConfig{}
+	conf.Server.Port = "8080"
+	conf.Server.Timeout = 5 * time.Second
+	conf.Database.Password = "secret"
+	conf.Tracing.Headers = map[string]string{"authorization": "Bearer token"}
+
+	var buf bytes.Buffer
+	if err := Print(&buf, conf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{`port: "8080"`, "timeout: 5s", "password: " + Redacted, "authorization: " + Redacted, `host: ""`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("printed config has no %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "secret") || strings.Contains(out, "Bearer") || strings.Contains(out, "Ignored") {
+		t.Errorf("printed config:\n%s", out)
+	}
+}
+
+func TestWatcher(t *testing.T) {
+
+	dir := writeConfig(t, testFile)
+	conf := &testConfig{}
+	w, err := NewWatcher(conf, Options{Dir: dir}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := make(chan Event, 10)
+	w.OnChange(func(e Event) { events <- e })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.Run(ctx) }()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// file is written until the change is noticed, watcher starts asynchronously
+	write := func(content string) Event {
+		t.Helper()
+		deadline := time.After(5 * time.Second)
+		for {
+			if err := ioutil.WriteFile(filepath.Join(dir, "main.yaml"), []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case e := <-events:
+				return e
+			case <-time.After(200 * time.Millisecond):
+			case <-deadline:
+				t.Fatal("config change is not noticed")
+			}
+		}
+	}
+
+	e := write(strings.Replace(testFile, "timeout: 5s", "timeout: 10s", 1))
+	if e.Source != SourceFile || !reflect.DeepEqual(e.Changed, []string{"server.timeout"}) ||
+		!e.Has("server") || e.Has("database") {
+		t.Errorf("event = %+v", e)
+	}
+	if e.Old.(*testConfig).Server.Timeout != 5*time.Second || e.New.(*testConfig).Server.Timeout != 10*time.Second {
+		t.Errorf("timeouts = %s -> %s", e.Old.(*testConfig).Server.Timeout, e.New.(*testConfig).Server.Timeout)
+	}
+	if conf.Server.Timeout != 5*time.Second {
+		t.Error("parsed config is modified by watcher")
+	}
+
+	// invalid config is skipped, the next valid one is compared with the last valid one
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.yaml"), []byte("server:\n  port: \"\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	e = write(strings.Replace(testFile, "- name: fake", "- name: manual", 1))
+	if !reflect.DeepEqual(e.Changed, []string{"server.timeout", "providers"}) {
+		t.Errorf("changed keys = %v", e.Changed)
+	}
+	if w.Current() != e.New {
+		t.Error("current config is not the reloaded one")
+	}
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Keys returns keys of fields of the config struct which can be set from a single environment variable,
+// keys of nested structs are joined with dots, e.g. server.tls.certFile. Maps and lists of structs,
+// such as provider list, have no key, they are set in config sources only.
+func Keys(configStruct interface{}) []string {
+
+	t := reflect.TypeOf(configStruct)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	return appendKeys(nil, t, "")
+}
+
+func appendKeys(keys []string, t reflect.Type, prefix string) []string {
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := fieldKey(f)
+		if f.PkgPath != "" || name == "" {
+			continue
+		}
+		key := prefix + name
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch {
+		case ft == reflect.TypeOf(time.Time{}):
+			keys = append(keys, key)
+		case ft.Kind() == reflect.Struct:
+			keys = appendKeys(keys, ft, key+".")
+		case ft.Kind() == reflect.Map, ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Struct:
+		default:
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// fieldKey returns config key of the struct field, it is empty for ignored fields.
+func fieldKey(f reflect.StructField) string {
+
+	name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	default:
+		return name
+	}
+}
+
+// EnvVar returns name of environment variable overriding the key, e.g. INVEST_SERVER_TLS_CERTFILE
+// for key server.tls.certFile and prefix INVEST. Lists are comma separated in environment variables.
+func EnvVar(prefix, key string) string {
+
+	name := strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+	if prefix == "" {
+		return name
+	}
+	return strings.ToUpper(prefix) + "_" + name
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"github.com/ghodss/yaml"
+	"io"
+	"reflect"
+	"time"
+)
+
+// Redacted replaces values of secret fields in printed config.
+const Redacted = "REDACTED"
+
+// Print writes config struct as YAML with the keys of Parse. Values of fields tagged with `secret:"true"`
+// are replaced with Redacted unless they are empty, all values are redacted for secret maps and lists.
+func Print(w io.Writer, configStruct interface{}) error {
+
+	out, err := yaml.Marshal(printable(reflect.ValueOf(configStruct), false))
+	if err != nil {
+		return fmt.Errorf("problem while encoding config: %w", err)
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// printable converts value to maps, lists and scalars keyed by config keys.
+func printable(v reflect.Value, secret bool) interface{} {
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return redact(t.Format(time.RFC3339), secret)
+		}
+		result := map[string]interface{}{}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			key := fieldKey(f)
+			if f.PkgPath != "" || key == "" {
+				continue
+			}
+			result[key] = printable(v.Field(i), secret || f.Tag.Get("secret") == "true")
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		result := map[string]interface{}{}
+		iter := v.MapRange()
+		for iter.Next() {
+			result[fmt.Sprint(iter.Key().Interface())] = printable(iter.Value(), secret)
+		}
+		return result
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		result := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			result = append(result, printable(v.Index(i), secret))
+		}
+		return result
+	default:
+		if d, ok := v.Interface().(time.Duration); ok {
+			return redact(d.String(), secret)
+		}
+		return redact(v.Interface(), secret)
+	}
+}
+
+func redact(value interface{}, secret bool) interface{} {
+
+	if secret && !reflect.ValueOf(value).IsZero() {
+		return Redacted
+	}
+	return value
+}
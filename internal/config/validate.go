@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"reflect"
+	"strings"
+)
+
+// ValidationError lists all invalid fields of config.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// FieldError describes invalid field of config by its key, e.g. server.tls.certFile.
+type FieldError struct {
+	Key     string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+
+	lines := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		lines = append(lines, f.Key+" "+f.Message)
+	}
+	return "invalid config: " + strings.Join(lines, "; ")
+}
+
+// Validate checks config struct by `validate` struct tags, see github.com/go-playground/validator.
+func Validate(configStruct interface{}) error {
+
+	validate := validator.New()
+	validate.RegisterTagNameFunc(fieldKey)
+	err := validate.Struct(configStruct)
+
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+	result := &ValidationError{}
+	for _, fe := range fieldErrs {
+		key := fe.Namespace()
+		// namespace starts with name of config type
+		if i := strings.Index(key, "."); i >= 0 {
+			key = key[i+1:]
+		}
+		result.Fields = append(result.Fields, FieldError{Key: key, Message: message(fe)})
+	}
+	return result
+}
+
+// message returns human-readable description of failed validation.
+func message(fe validator.FieldError) string {
+
+	param := fe.Param()
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "required_if":
+		// param is a list of field and value pairs
+		var conditions []string
+		parts := strings.Fields(param)
+		for i := 0; i+1 < len(parts); i += 2 {
+			conditions = append(conditions, parts[i]+" is "+parts[i+1])
+		}
+		return "is required when " + strings.Join(conditions, " and ")
+	case "required_with":
+		return "is required with " + param
+	case "oneof":
+		return fmt.Sprintf("must be one of %s, got %v", strings.Join(strings.Fields(param), ", "), fe.Value())
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s%s, got %v", param, unit(fe), fe.Value())
+	case "max", "lte":
+		return fmt.Sprintf("must be at most %s%s, got %v", param, unit(fe), fe.Value())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s%s, got %v", param, unit(fe), fe.Value())
+	case "lt":
+		return fmt.Sprintf("must be less than %s%s, got %v", param, unit(fe), fe.Value())
+	case "url":
+		return fmt.Sprintf("must be URL, got %v", fe.Value())
+	case "hostname_port":
+		return fmt.Sprintf("must be host:port, got %v", fe.Value())
+	default:
+		return fmt.Sprintf("fails %s validation, got %v", strings.TrimSuffix(fe.Tag()+"="+param, "="), fe.Value())
+	}
+}
+
+// unit explains what limits of min and max tags mean for strings, lists and maps.
+func unit(fe validator.FieldError) string {
+
+	switch fe.Kind() {
+	case reflect.String:
+		return " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return " items"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,266 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"github.com/fsnotify/fsnotify"
+	consul "github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// reloadDelay collects file events of a single save, editors write files in several steps.
+	reloadDelay = 100 * time.Millisecond
+	// consulWaitTime bounds blocking query, Consul answers earlier when the key changes.
+	consulWaitTime   = 5 * time.Minute
+	consulRetryDelay = 5 * time.Second
+)
+
+// Sources of Event.
+const (
+	SourceFile   = "file"
+	SourceConsul = "consul"
+)
+
+// Event describes config reloaded after change of its source.
+type Event struct {
+	Source  string      // SourceFile or SourceConsul
+	Old     interface{} // pointer to previous config struct
+	New     interface{} // pointer to reloaded config struct of the same type
+	Changed []string    // keys of changed fields, e.g. logger.level, maps and lists are compared as a whole
+}
+
+// Has reports whether the key or keys nested in it changed, e.g. Has("alerts") is true when alerts.interval changed.
+func (e Event) Has(key string) bool {
+
+	for _, changed := range e.Changed {
+		if changed == key || strings.HasPrefix(changed, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Watcher reloads config when its file or Consul key changes and passes changes to handlers.
+// Config is reloaded from all sources like Parse does, Vault and environment variables are not watched
+// but their values are applied on reload. Invalid config is logged and skipped.
+type Watcher struct {
+	opts    Options
+	log     *zap.Logger
+	sources sources
+
+	mu       sync.Mutex
+	current  interface{}
+	handlers []func(Event)
+}
+
+// NewWatcher parses configStruct like Parse and returns watcher of its sources.
+func NewWatcher(configStruct interface{}, opts Options, log *zap.Logger) (*Watcher, error) {
+
+	if log == nil {
+		log = zap.NewNop()
+	}
+	src, err := parse(configStruct, opts, log)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.fill(); err != nil {
+		return nil, err
+	}
+	return &Watcher{
+		opts:    opts,
+		log:     log.With(zap.String("service", "config")),
+		sources: src,
+		current: configStruct,
+	}, nil
+}
+
+// OnChange registers handler of config changes, handlers are called one by one in order of registration.
+func (w *Watcher) OnChange(handler func(Event)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handlers = append(w.handlers, handler)
+}
+
+// Current returns pointer to the latest valid config, it is not modified by watcher.
+func (w *Watcher) Current() interface{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.current
+}
+
+// Run watches config file and Consul key until context is done.
+func (w *Watcher) Run(ctx context.Context) error {
+
+	reloads := make(chan string)
+	g, ctx := errgroup.WithContext(ctx)
+	if w.sources.file != "" {
+		g.Go(func() error { return w.watchFile(ctx, reloads) })
+	}
+	if w.sources.consulKey != "" {
+		g.Go(func() error { return w.watchConsul(ctx, reloads) })
+	}
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case source := <-reloads:
+				w.reload(source)
+			}
+		}
+	})
+	return g.Wait()
+}
+
+// watchFile requests reload when config file is written, created or replaced.
+func (w *Watcher) watchFile(ctx context.Context, reloads chan<- string) error {
+
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("problem while creating config file watcher: %w", err)
+	}
+	defer fw.Close()
+	// directory is watched as editors and Kubernetes replace files instead of writing them
+	dir := filepath.Dir(w.sources.file)
+	if err := fw.Add(dir); err != nil {
+		return fmt.Errorf("problem while watching config directory %s: %w", dir, err)
+	}
+	w.log.Info("watching config file", zap.String("path", w.sources.file))
+
+	var delay <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-fw.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod || !w.isConfigFile(event.Name) {
+				continue
+			}
+			delay = time.After(reloadDelay)
+		case err, ok := <-fw.Errors:
+			if !ok {
+				return nil
+			}
+			w.log.Warn("problem while watching config file", zap.Error(err))
+		case <-delay:
+			delay = nil
+			select {
+			case reloads <- SourceFile:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// isConfigFile reports whether event of the path may change config, ..data is a symlink Kubernetes
+// swaps on update of mounted config map.
+func (w *Watcher) isConfigFile(path string) bool {
+
+	switch filepath.Base(path) {
+	case w.opts.DevFile, w.opts.ProdFile, "..data":
+		return true
+	default:
+		return false
+	}
+}
+
+// watchConsul requests reload when index of config key changes, see Consul blocking queries.
+func (w *Watcher) watchConsul(ctx context.Context, reloads chan<- string) error {
+
+	kv, key, err := consulKV()
+	if err != nil {
+		return fmt.Errorf("problem while creating consul client: %w", err)
+	}
+	w.log.Info("watching consul key", zap.String("key", key))
+
+	var index uint64
+	for {
+		_, meta, err := kv.Get(key, (&consul.QueryOptions{WaitIndex: index, WaitTime: consulWaitTime}).WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			w.log.Warn("problem while watching consul key", zap.String("key", key), zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(consulRetryDelay):
+			}
+			continue
+		}
+
+		switch {
+		case meta.LastIndex < index:
+			// index goes back on reset of Consul state, so the key is read again
+			index = 0
+		case index != 0 && meta.LastIndex != index:
+			index = meta.LastIndex
+			select {
+			case reloads <- SourceConsul:
+			case <-ctx.Done():
+				return nil
+			}
+		default:
+			index = meta.LastIndex
+		}
+	}
+}
+
+// reload parses config again and passes it to handlers when it is valid and differs from the current one.
+func (w *Watcher) reload(source string) {
+
+	old := w.Current()
+	next := reflect.New(reflect.TypeOf(old).Elem()).Interface()
+	if _, err := parse(next, w.opts, zap.NewNop()); err != nil {
+		w.log.Error("problem while reloading config, previous config is kept", zap.String("source", source), zap.Error(err))
+		return
+	}
+	changed := changedKeys(reflect.ValueOf(old).Elem(), reflect.ValueOf(next).Elem(), "")
+	if len(changed) == 0 {
+		return
+	}
+	w.log.Info("config changed", zap.String("source", source), zap.Strings("keys", changed))
+
+	w.mu.Lock()
+	w.current = next
+	handlers := append([]func(Event){}, w.handlers...)
+	w.mu.Unlock()
+
+	event := Event{Source: source, Old: old, New: next, Changed: changed}
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// changedKeys returns keys of fields which differ between structs of the same type.
+func changedKeys(old, next reflect.Value, prefix string) []string {
+
+	var keys []string
+	for i := 0; i < old.NumField(); i++ {
+		f := old.Type().Field(i)
+		name := fieldKey(f)
+		if f.PkgPath != "" || name == "" {
+			continue
+		}
+		key := prefix + name
+		if f.Type.Kind() == reflect.Struct && f.Type != reflect.TypeOf(time.Time{}) {
+			keys = append(keys, changedKeys(old.Field(i), next.Field(i), key+".")...)
+			continue
+		}
+		if !reflect.DeepEqual(old.Field(i).Interface(), next.Field(i).Interface()) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,174 @@
+package consolidation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"sort"
+	"sync"
+)
+
+// Source is a portfolio of a single account of a provider, or a failure to load it.
+type Source struct {
+	Provider  invest.ProviderID
+	Account   *pb.Account
+	Portfolio *pb.PortfolioResponse
+	Err       error
+}
+
+// Portfolio concurrently loads portfolios of every account of every provider and merges them.
+// Failure of a provider or an account does not fail the whole request and is reported among sources,
+// error is returned only when no portfolio was loaded at all.
+func Portfolio(ctx context.Context, providers map[invest.ProviderID]invest.Provider) (*pb.ConsolidatedPortfolioResponse, error) {
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sources []Source
+		add     = func(source Source) {
+			mu.Lock()
+			sources = append(sources, source)
+			mu.Unlock()
+		}
+	)
+
+	for id, provider := range providers {
+		id, provider := id, provider
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			accounts, err := provider.Accounts(ctx, &pb.AccountsRequest{})
+			if err != nil {
+				add(Source{Provider: id, Err: fmt.Errorf("load accounts: %w", err)})
+				return
+			}
+
+			for _, account := range accounts.Accounts {
+				account := account
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					portfolio, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: account})
+					add(Source{Provider: id, Account: account, Portfolio: portfolio, Err: err})
+				}()
+			}
+		}()
+	}
+	wg.Wait()
+
+	resp := Merge(sources)
+	if len(resp.Sources) == 0 && len(resp.Failures) > 0 {
+		return nil, errors.New("no portfolio was loaded: " + resp.Failures[0].Error)
+	}
+	return resp, nil
+}
+
+// Merge combines portfolios of sources, positions are merged by FIGI, or by ISIN when FIGI is unknown,
+// and by currency of their value, so holdings of the same instrument in different currencies stay apart.
+// Currencies are merged by currency code. Every position keeps its per-source breakdown.
+func Merge(sources []Source) *pb.ConsolidatedPortfolioResponse {
+
+	sources = append([]Source(nil), sources...)
+	sort.SliceStable(sources, func(i, j int) bool {
+		if sources[i].Provider != sources[j].Provider {
+			return sources[i].Provider < sources[j].Provider
+		}
+		return sources[i].Account.GetAccountId() < sources[j].Account.GetAccountId()
+	})
+
+	var (
+		resp       = &pb.ConsolidatedPortfolioResponse{}
+		byFigi     = make(map[string]*pb.ConsolidatedPosition)
+		byIsin     = make(map[string]*pb.ConsolidatedPosition)
+		currencies = make(map[string]*pb.CurrencyBalance)
+	)
+
+	for _, source := range sources {
+		provider := source.Provider.String()
+		accountID := source.Account.GetAccountId()
+
+		if source.Err != nil {
+			resp.Failures = append(resp.Failures, &pb.SourceFailure{
+				Provider:  provider,
+				AccountId: accountID,
+				Error:     source.Err.Error(),
+			})
+			continue
+		}
+		if source.Portfolio == nil {
+			continue
+		}
+
+		resp.Sources = append(resp.Sources, &pb.PortfolioSource{
+			Provider:  provider,
+			Account:   source.Account,
+			Positions: int32(len(source.Portfolio.Positions)),
+		})
+
+		for _, position := range source.Portfolio.Positions {
+			value, currency := invest.PositionValue(position)
+			merged := byFigi[positionKey(position.Figi, currency)]
+			if merged == nil {
+				merged = byIsin[positionKey(position.Isin, currency)]
+			}
+			if merged == nil {
+				merged = &pb.ConsolidatedPosition{
+					InstrumentType: position.InstrumentType,
+					Value:          &pb.Yield{Currency: currency},
+					ExpectedYield:  &pb.Yield{},
+				}
+				resp.Positions = append(resp.Positions, merged)
+			}
+			fill(&merged.Figi, position.Figi)
+			fill(&merged.Ticker, position.Ticker)
+			fill(&merged.Isin, position.Isin)
+			fill(&merged.Name, position.Name)
+			if merged.Figi != "" {
+				byFigi[positionKey(merged.Figi, currency)] = merged
+			}
+			if merged.Isin != "" {
+				byIsin[positionKey(merged.Isin, currency)] = merged
+			}
+
+			merged.Balance += position.Balance
+			merged.Value.Value += value
+			if yield := position.ExpectedYield; yield != nil {
+				merged.ExpectedYield.Value += yield.Value
+				fill(&merged.ExpectedYield.Currency, yield.Currency)
+			}
+			merged.Sources = append(merged.Sources, &pb.PositionSource{
+				Provider:  provider,
+				AccountId: accountID,
+				Balance:   position.Balance,
+				Value:     &pb.Yield{Currency: currency, Value: value},
+			})
+		}
+
+		for _, balance := range source.Portfolio.Currencies {
+			merged, found := currencies[balance.Currency]
+			if !found {
+				merged = &pb.CurrencyBalance{Currency: balance.Currency}
+				currencies[balance.Currency] = merged
+				resp.Currencies = append(resp.Currencies, merged)
+			}
+			merged.Balance += balance.Balance
+			merged.Blocked += balance.Blocked
+		}
+	}
+
+	return resp
+}
+
+// positionKey identifies merged position by instrument identifier and currency of its value.
+func positionKey(id, currency string) string {
+	return id + "/" + currency
+}
+
+func fill(dst *string, value string) {
+	if *dst == "" {
+		*dst = value
+	}
+}
@@ -0,0 +1,111 @@
+package consolidation
+
+import (
+	"errors"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+
+	sources := []Source{
+		{
+			Provider: 1,
+			Account:  &pb.Account{AccountId: "2"},
+			Portfolio: &pb.PortfolioResponse{
+				Positions: []*pb.Position{{
+					Figi:                 "BBG000B9XRY4",
+					Isin:                 "US0378331005",
+					Balance:              1,
+					AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 100},
+					ExpectedYield:        &pb.Yield{Currency: "USD", Value: 10},
+				}},
+				Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 100}},
+			},
+		},
+		{
+			Provider: 1,
+			Account:  &pb.Account{AccountId: "1"},
+			Portfolio: &pb.PortfolioResponse{
+				Positions: []*pb.Position{{
+					Isin:                 "US0378331005",
+					Ticker:               "AAPL",
+					Balance:              2,
+					AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 100},
+				}},
+				Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 50, Blocked: 10}},
+			},
+		},
+		{
+			Provider: 1,
+			Account:  &pb.Account{AccountId: "3"},
+			Err:      errors.New("unavailable"),
+		},
+	}
+
+	resp := Merge(sources)
+
+	if len(resp.Positions) != 1 {
+		t.Fatalf("positions are not merged by isin: %v", resp.Positions)
+	}
+	position := resp.Positions[0]
+	if position.Figi != "BBG000B9XRY4" || position.Ticker != "AAPL" || position.Balance != 3 {
+		t.Errorf("unexpected merged position %v", position)
+	}
+	if position.Value.Value != 310 || position.Value.Currency != "USD" {
+		t.Errorf("unexpected merged value %v", position.Value)
+	}
+	if len(position.Sources) != 2 || position.Sources[0].AccountId != "1" {
+		t.Errorf("unexpected position sources %v", position.Sources)
+	}
+
+	if len(resp.Currencies) != 1 || resp.Currencies[0].Balance != 150 || resp.Currencies[0].Blocked != 10 {
+		t.Errorf("unexpected currencies %v", resp.Currencies)
+	}
+
+	if len(resp.Sources) != 2 || len(resp.Failures) != 1 || resp.Failures[0].AccountId != "3" {
+		t.Errorf("unexpected sources %v / failures %v", resp.Sources, resp.Failures)
+	}
+}
+
+func TestMergeCurrencies(t *testing.T) {
+
+	sources := []Source{
+		{
+			Provider: 2,
+			Account:  &pb.Account{AccountId: "ibkr"},
+			Portfolio: &pb.PortfolioResponse{Positions: []*pb.Position{{
+				Figi:                 "BBG004730N88",
+				Balance:              10,
+				AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 3},
+				ExpectedYield:        &pb.Yield{Currency: "USD", Value: 1},
+			}}},
+		},
+		{
+			Provider: 1,
+			Account:  &pb.Account{AccountId: "2000"},
+			Portfolio: &pb.PortfolioResponse{Positions: []*pb.Position{{
+				Figi:                 "BBG004730N88",
+				Balance:              10,
+				AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 250},
+				ExpectedYield:        &pb.Yield{Currency: "RUB", Value: 50},
+			}}},
+		},
+	}
+
+	resp := Merge(sources)
+
+	if sources[0].Account.AccountId != "ibkr" {
+		t.Error("sources of caller are reordered")
+	}
+	if len(resp.Positions) != 2 {
+		t.Fatalf("positions in different currencies are merged: %v", resp.Positions)
+	}
+	rub, usd := resp.Positions[0], resp.Positions[1]
+	if rub.Value.Currency != "RUB" || rub.Value.Value != 2550 || rub.ExpectedYield.Value != 50 || rub.Balance != 10 {
+		t.Errorf("unexpected rouble position %v", rub)
+	}
+	if usd.Value.Currency != "USD" || usd.Value.Value != 31 || usd.ExpectedYield.Value != 1 || usd.Balance != 10 {
+		t.Errorf("unexpected dollar position %v", usd)
+	}
+}
@@ -0,0 +1,154 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"golang.org/x/sync/errgroup"
+	"strings"
+	"time"
+)
+
+// Reports which can be exported.
+const (
+	ReportPositions  = "positions"
+	ReportOperations = "operations"
+	ReportPnL        = "pnl"
+	ReportIncome     = "income"
+)
+
+// historyStart is the date operations are loaded from when request does not specify period.
+var historyStart = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Report loads data of the requested report for the requested account, or for all provider accounts
+// when account is not set, and renders it in the requested format.
+func Report(ctx context.Context, provider invest.Provider, req *pb.ExportReportRequest) (*pb.ExportReportResponse, error) {
+
+	builder, found := builders[req.Report]
+	if !found {
+		return nil, fmt.Errorf("%w: unknown report %q, supported reports are %s",
+			invest.ErrInvalidArgument, req.Report, strings.Join(Reports(), ", "))
+	}
+	renderer, found := renderers[req.Format]
+	if !found {
+		return nil, fmt.Errorf("%w: unknown format %q, supported formats are %s",
+			invest.ErrInvalidArgument, req.Format, strings.Join(Formats(), ", "))
+	}
+	locale, err := LocaleByName(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := req.From, req.To
+	if from == "" {
+		from = historyStart.Format(time.RFC3339)
+	}
+	if to == "" {
+		to = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	accounts, err := loadAccounts(ctx, provider, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	table := Table{Name: req.Report}
+	g, gctx := errgroup.WithContext(ctx)
+	rows := make([][][]interface{}, len(accounts))
+	for i, account := range accounts {
+		i, account := i, account
+		g.Go(func() error {
+			var err error
+			rows[i], err = builder.rows(gctx, provider, account, from, to)
+			if err != nil {
+				return fmt.Errorf("load %s of account %s: %w", req.Report, account.AccountId, err)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	table.Columns = builder.columns
+	for _, accountRows := range rows {
+		table.Rows = append(table.Rows, accountRows...)
+	}
+
+	table, err = table.Select(req.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := renderer.render(table, locale)
+	if err != nil {
+		return nil, fmt.Errorf("render %s: %w", req.Format, err)
+	}
+
+	return &pb.ExportReportResponse{
+		Filename:    filename(req.Report, req.Account, req.Format),
+		ContentType: renderer.contentType,
+		Content:     content,
+	}, nil
+}
+
+// loadAccounts returns the requested account or all provider accounts when account is not set.
+func loadAccounts(ctx context.Context, provider invest.Provider, account *pb.Account) ([]*pb.Account, error) {
+	if account != nil && account.AccountId != "" {
+		return []*pb.Account{account}, nil
+	}
+	accounts, err := provider.Accounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("load accounts: %w", err)
+	}
+	return accounts.Accounts, nil
+}
+
+func filename(report string, account *pb.Account, format string) string {
+	name := "all"
+	if account != nil && account.AccountId != "" {
+		name = account.AccountId
+	}
+	return fmt.Sprintf("%s-%s-%s.%s", report, name, time.Now().UTC().Format("20060102"), format)
+}
+
+// Table is a report prepared for rendering, every row has a value per column.
+// Values are strings, float64 numbers or time.Time dates.
+type Table struct {
+	Name    string
+	Columns []string
+	Rows    [][]interface{}
+}
+
+// Select returns table with the given columns in the given order, all columns are kept when none are given.
+func (t Table) Select(columns []string) (Table, error) {
+	if len(columns) == 0 {
+		return t, nil
+	}
+
+	indexes := make([]int, 0, len(columns))
+	for _, column := range columns {
+		index := -1
+		for i, c := range t.Columns {
+			if c == column {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return Table{}, fmt.Errorf("%w: unknown column %q of report %s, available columns are %s",
+				invest.ErrInvalidArgument, column, t.Name, strings.Join(t.Columns, ", "))
+		}
+		indexes = append(indexes, index)
+	}
+
+	selected := Table{Name: t.Name, Columns: columns, Rows: make([][]interface{}, 0, len(t.Rows))}
+	for _, row := range t.Rows {
+		values := make([]interface{}, 0, len(indexes))
+		for _, index := range indexes {
+			values = append(values, row[index])
+		}
+		selected.Rows = append(selected.Rows, values)
+	}
+	return selected, nil
+}
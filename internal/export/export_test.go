@@ -0,0 +1,233 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testProvider struct {
+	invest.Provider
+}
+
+func (testProvider) Accounts(context.Context, *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	return &pb.AccountsResponse{Accounts: []*pb.Account{
+		{AccountId: "1", Provider: "tinkoff"},
+		{AccountId: "2", Provider: "manual"},
+	}}, nil
+}
+
+func (testProvider) Portfolio(_ context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	if req.Account.AccountId == "2" {
+		return &pb.PortfolioResponse{}, nil
+	}
+	return &pb.PortfolioResponse{Positions: []*pb.Position{
+		{
+			Figi:                 "BBG000B9XRY4",
+			Ticker:               "AAPL",
+			Name:                 "Apple, Inc.",
+			InstrumentType:       "Stock",
+			Balance:              10,
+			Lots:                 10,
+			AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 120.5},
+			ExpectedYield:        &pb.Yield{Currency: "USD", Value: 1205},
+		},
+		{
+			Figi:                 "BBG0013HGFT4",
+			Ticker:               "USD000UTSTOM",
+			InstrumentType:       invest.InstrumentTypeCurrency,
+			Balance:              100,
+			AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 75},
+		},
+	}}, nil
+}
+
+func (testProvider) Operations(_ context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+	if req.From == "" || req.To == "" {
+		return nil, errors.New("period is required")
+	}
+	return &pb.OperationsResponse{Operations: []*pb.Operation{
+		{Id: "1", OperationType: pb.OperationType_OPERATION_TYPE_BUY, Figi: "BBG000B9XRY4", Date: "2021-01-15T10:30:00+03:00",
+			Quantity: 10, Price: 120.5, Payment: -1205, Currency: "USD", Commission: &pb.Yield{Currency: "USD", Value: -0.6}},
+		{Id: "2", OperationType: pb.OperationType_OPERATION_TYPE_DIVIDEND, Figi: "BBG000B9XRY4", Date: "2021-02-15T10:30:00+03:00",
+			Payment: 2.05, Currency: "USD"},
+		{Id: "3", OperationType: pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND, Figi: "BBG000B9XRY4", Date: "2021-02-15T10:30:00+03:00",
+			Payment: -0.21, Currency: "USD"},
+		{Id: "4", OperationType: pb.OperationType_OPERATION_TYPE_DIVIDEND, Figi: "BBG000B9XRY4", Date: "2021-05-15T10:30:00+03:00",
+			Payment: 2.2, Currency: "USD"},
+	}}, nil
+}
+
+func TestReportCSV(t *testing.T) {
+
+	resp, err := Report(context.Background(), testProvider{}, &pb.ExportReportRequest{
+		Account: &pb.Account{AccountId: "1", Provider: "tinkoff"},
+		Report:  ReportOperations,
+		Format:  FormatCSV,
+		Columns: []string{"date", "operation_type", "payment", "currency"},
+		Locale:  "ru-RU",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "date;operation_type;payment;currency\n" +
+		"15.01.2021 10:30:00;buy;-1205;USD\n" +
+		"15.02.2021 10:30:00;dividend;2,05;USD\n" +
+		"15.02.2021 10:30:00;tax_dividend;-0,21;USD\n" +
+		"15.05.2021 10:30:00;dividend;2,2;USD\n"
+	if string(resp.Content) != expected {
+		t.Errorf("unexpected csv:\n%s", resp.Content)
+	}
+	if resp.ContentType != "text/csv; charset=utf-8" || !strings.HasPrefix(resp.Filename, "operations-1-") ||
+		!strings.HasSuffix(resp.Filename, ".csv") {
+		t.Errorf("unexpected file %s of %s", resp.Filename, resp.ContentType)
+	}
+}
+
+func TestReportJSON(t *testing.T) {
+
+	resp, err := Report(context.Background(), testProvider{}, &pb.ExportReportRequest{
+		Report: ReportPnL,
+		Format: FormatJSON,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// currency positions are skipped, all accounts are exported
+	if !strings.HasPrefix(string(resp.Content), `[{"provider":"tinkoff","account":"1","figi":"BBG000B9XRY4"`) {
+		t.Errorf("columns order is not kept: %s", resp.Content)
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(resp.Content, &rows); err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("unexpected rows %v", rows)
+	}
+	row := rows[0]
+	if row["cost"] != 1205.0 || row["value"] != 2410.0 || row["pnl"] != 1205.0 || row["pnl_percent"] != 100.0 ||
+		row["price"] != 241.0 || row["currency"] != "USD" {
+		t.Errorf("unexpected pnl %v", row)
+	}
+}
+
+func TestReportIncome(t *testing.T) {
+
+	resp, err := Report(context.Background(), testProvider{}, &pb.ExportReportRequest{
+		Account: &pb.Account{AccountId: "1"},
+		Report:  ReportIncome,
+		Format:  FormatCSV,
+		Columns: []string{"figi", "payments", "dividends", "tax", "net"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "figi,payments,dividends,tax,net\nBBG000B9XRY4,2,4.25,-0.21,4.04\n"
+	if string(resp.Content) != expected {
+		t.Errorf("unexpected income:\n%s", resp.Content)
+	}
+}
+
+func TestReportXLSX(t *testing.T) {
+
+	resp, err := Report(context.Background(), testProvider{}, &pb.ExportReportRequest{
+		Account: &pb.Account{AccountId: "1"},
+		Report:  ReportOperations,
+		Format:  FormatXLSX,
+		Columns: []string{"id", "date", "payment"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(strings.NewReader(string(resp.Content)), int64(len(resp.Content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sheet string
+	for _, file := range archive.File {
+		if file.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sheet = string(data)
+	}
+
+	for _, cell := range []string{
+		`<c r="A1" t="inlineStr"><is><t>id</t></is></c>`,
+		`<c r="B2" s="1"><v>44211.4375</v></c>`,
+		`<c r="C2"><v>-1205</v></c>`,
+		`<c r="C3"><v>2.05</v></c>`,
+	} {
+		if !strings.Contains(sheet, cell) {
+			t.Errorf("cell %s is not found in sheet:\n%s", cell, sheet)
+		}
+	}
+}
+
+func TestReportInvalidArguments(t *testing.T) {
+
+	requests := map[string]*pb.ExportReportRequest{
+		"unknown report": {Report: "balance", Format: FormatCSV},
+		"unknown format": {Report: ReportPositions, Format: "pdf"},
+		"unknown locale": {Report: ReportPositions, Format: FormatCSV, Locale: "xx"},
+		"unknown column": {Report: ReportPositions, Format: FormatCSV, Columns: []string{"figi", "payment"}},
+	}
+
+	for name, req := range requests {
+		if _, err := Report(context.Background(), testProvider{}, req); !errors.Is(err, invest.ErrInvalidArgument) {
+			t.Errorf("%s: expected invalid argument, got %v", name, err)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+
+	server := httptest.NewServer(Handler(testProvider{}, zap.NewNop()))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/positions.csv?account=1&provider=tinkoff&columns=ticker,name,balance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Disposition"), "attachment; filename=positions-1-") {
+		t.Errorf("unexpected response %d %v", resp.StatusCode, resp.Header)
+	}
+	if string(body) != "ticker,name,balance\nAAPL,\"Apple, Inc.\",10\nUSD000UTSTOM,,100\n" {
+		t.Errorf("unexpected positions:\n%s", body)
+	}
+
+	resp, err = http.Get(server.URL + "/positions.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected bad request for unknown format, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,178 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"goinvest/internal/invest"
+	"goinvest/internal/xlsx"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats reports can be rendered in.
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+	FormatJSON = "json"
+)
+
+type renderer struct {
+	contentType string
+	render      func(table Table, locale Locale) ([]byte, error)
+}
+
+var renderers = map[string]renderer{
+	FormatCSV:  {contentType: "text/csv; charset=utf-8", render: renderCSV},
+	FormatXLSX: {contentType: xlsx.ContentType, render: renderXLSX},
+	FormatJSON: {contentType: "application/json", render: renderJSON},
+}
+
+// Formats returns formats reports can be rendered in.
+func Formats() []string {
+	formats := make([]string, 0, len(renderers))
+	for format := range renderers {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// Locale defines how numbers and dates are written to CSV so that spreadsheets of the locale
+// open it without import settings. XLSX and JSON keep typed values and do not depend on locale.
+type Locale struct {
+	Name string
+	// DecimalSeparator separates fractional part of numbers.
+	DecimalSeparator string
+	// Delimiter separates CSV fields, semicolon is used where comma is the decimal separator.
+	Delimiter rune
+	// DateLayout is a time layout of dates.
+	DateLayout string
+}
+
+var locales = map[string]Locale{
+	"en": {Name: "en", DecimalSeparator: ".", Delimiter: ',', DateLayout: "2006-01-02 15:04:05"},
+	"ru": {Name: "ru", DecimalSeparator: ",", Delimiter: ';', DateLayout: "02.01.2006 15:04:05"},
+	"de": {Name: "de", DecimalSeparator: ",", Delimiter: ';', DateLayout: "02.01.2006 15:04:05"},
+}
+
+// LocaleByName returns locale by its language code, e.g. ru or ru-RU. Empty name is english locale.
+func LocaleByName(name string) (Locale, error) {
+	if name == "" {
+		return locales["en"], nil
+	}
+	language := strings.ToLower(name)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+	locale, found := locales[language]
+	if !found {
+		return Locale{}, fmt.Errorf("%w: unknown locale %q", invest.ErrInvalidArgument, name)
+	}
+	return locale, nil
+}
+
+// FormatNumber writes number with locale decimal separator using the shortest exact representation.
+func (l Locale) FormatNumber(value float64) string {
+	formatted := strconv.FormatFloat(value, 'f', -1, 64)
+	if l.DecimalSeparator != "." {
+		formatted = strings.Replace(formatted, ".", l.DecimalSeparator, 1)
+	}
+	return formatted
+}
+
+// Format writes value of table cell as text.
+func (l Locale) Format(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return l.FormatNumber(v)
+	case time.Time:
+		return v.Format(l.DateLayout)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func renderCSV(table Table, locale Locale) ([]byte, error) {
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = locale.Delimiter
+
+	if err := w.Write(table.Columns); err != nil {
+		return nil, err
+	}
+	record := make([]string, len(table.Columns))
+	for _, row := range table.Rows {
+		for i, value := range row {
+			record[i] = locale.Format(value)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderXLSX writes table to the single worksheet of workbook named after the table.
+func renderXLSX(table Table, _ Locale) ([]byte, error) {
+
+	rows := make([][]interface{}, 0, len(table.Rows)+1)
+	header := make([]interface{}, len(table.Columns))
+	for i, column := range table.Columns {
+		header[i] = column
+	}
+	rows = append(rows, header)
+	rows = append(rows, table.Rows...)
+
+	var buf bytes.Buffer
+	if err := xlsx.Write(&buf, table.Name, rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderJSON writes table as an array of objects keeping order of columns,
+// numbers are written as is and dates in RFC 3339.
+func renderJSON(table Table, _ Locale) ([]byte, error) {
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, row := range table.Rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		for j, value := range row {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			key, err := json.Marshal(table.Columns[j])
+			if err != nil {
+				return nil, err
+			}
+			if date, ok := value.(time.Time); ok {
+				value = date.Format(time.RFC3339)
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(data)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,63 @@
+package export
+
+import (
+	"errors"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"goinvest/internal/tracing"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// Handler serves report downloads at /{report}.{format}, e.g. /operations.csv. Request is passed in query:
+//
+//	provider, account - account to export, all accounts are exported when account is not set;
+//	from, to          - RFC 3339 period of operations and income;
+//	columns           - comma separated columns in the required order;
+//	locale            - language code of CSV number and date format.
+func Handler(provider invest.Provider, logger *zap.Logger) http.Handler {
+
+	router := chi.NewRouter()
+	router.Get("/{report}.{format}", func(w http.ResponseWriter, r *http.Request) {
+
+		query := r.URL.Query()
+		req := &pb.ExportReportRequest{
+			Report: chi.URLParam(r, "report"),
+			Format: chi.URLParam(r, "format"),
+			Locale: query.Get("locale"),
+			From:   query.Get("from"),
+			To:     query.Get("to"),
+		}
+		if account := query.Get("account"); account != "" {
+			req.Account = &pb.Account{AccountId: account, Provider: query.Get("provider")}
+		}
+		if columns := query.Get("columns"); columns != "" {
+			req.Columns = strings.Split(columns, ",")
+		}
+
+		resp, err := Report(r.Context(), provider, req)
+		if err != nil {
+			switch {
+			case errors.Is(err, invest.ErrInvalidArgument):
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			case errors.Is(err, invest.ErrNotFound):
+				http.Error(w, err.Error(), http.StatusNotFound)
+			default:
+				tracing.Logger(r.Context(), logger).Error("problem while exporting report", zap.String("report", req.Report), zap.Error(err))
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", resp.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.Filename}))
+		if _, err := w.Write(resp.Content); err != nil {
+			tracing.Logger(r.Context(), logger).Warn("problem while writing exported report", zap.Error(err))
+		}
+	})
+
+	return router
+}
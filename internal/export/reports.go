@@ -0,0 +1,238 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"sort"
+	"strings"
+	"time"
+)
+
+// builder loads rows of a report for a single account, rows have a value per column.
+type builder struct {
+	columns []string
+	rows    func(ctx context.Context, provider invest.Provider, account *pb.Account, from, to string) ([][]interface{}, error)
+}
+
+var builders = map[string]builder{
+	ReportPositions: {
+		columns: []string{"provider", "account", "figi", "ticker", "isin", "name", "instrument_type",
+			"balance", "blocked", "lots", "average_price", "price", "value", "expected_yield", "currency"},
+		rows: positionsRows,
+	},
+	ReportOperations: {
+		columns: []string{"provider", "account", "id", "date", "operation_type", "figi", "instrument_type",
+			"quantity", "price", "payment", "currency", "commission", "commission_currency"},
+		rows: operationsRows,
+	},
+	ReportPnL: {
+		columns: []string{"provider", "account", "figi", "ticker", "name", "balance", "average_price", "price",
+			"cost", "value", "pnl", "pnl_percent", "currency"},
+		rows: pnlRows,
+	},
+	ReportIncome: {
+		columns: []string{"provider", "account", "figi", "currency", "payments", "dividends", "coupons", "tax", "net"},
+		rows:    incomeRows,
+	},
+}
+
+// Reports returns names of reports which can be exported.
+func Reports() []string {
+	reports := make([]string, 0, len(builders))
+	for report := range builders {
+		reports = append(reports, report)
+	}
+	sort.Strings(reports)
+	return reports
+}
+
+func positionsRows(ctx context.Context, provider invest.Provider, account *pb.Account, _, _ string) ([][]interface{}, error) {
+
+	portfolio, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: account})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]interface{}, 0, len(portfolio.Positions))
+	for _, position := range portfolio.Positions {
+		value, currency := invest.PositionValue(position)
+		price, _ := invest.PositionPrice(position)
+		rows = append(rows, []interface{}{
+			account.Provider,
+			account.AccountId,
+			position.Figi,
+			position.Ticker,
+			position.Isin,
+			position.Name,
+			position.InstrumentType,
+			position.Balance,
+			position.Blocked,
+			float64(position.Lots),
+			position.AveragePositionPrice.GetValue(),
+			price,
+			value,
+			position.ExpectedYield.GetValue(),
+			currency,
+		})
+	}
+	return rows, nil
+}
+
+func operationsRows(ctx context.Context, provider invest.Provider, account *pb.Account, from, to string) ([][]interface{}, error) {
+
+	operations, err := loadOperations(ctx, provider, account, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]interface{}, 0, len(operations))
+	for _, operation := range operations {
+		date, err := time.Parse(time.RFC3339, operation.Date)
+		if err != nil {
+			return nil, fmt.Errorf("operation %s has invalid date: %w", operation.Id, err)
+		}
+		rows = append(rows, []interface{}{
+			account.Provider,
+			account.AccountId,
+			operation.Id,
+			date,
+			operationType(operation.OperationType),
+			operation.Figi,
+			operation.InstrumentType,
+			float64(operation.Quantity),
+			operation.Price,
+			operation.Payment,
+			operation.Currency,
+			operation.Commission.GetValue(),
+			operation.Commission.GetCurrency(),
+		})
+	}
+	return rows, nil
+}
+
+// pnlRows reports unrealized profit and loss of positions, profit is the expected yield reported by broker.
+func pnlRows(ctx context.Context, provider invest.Provider, account *pb.Account, _, _ string) ([][]interface{}, error) {
+
+	portfolio, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: account})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]interface{}, 0, len(portfolio.Positions))
+	for _, position := range portfolio.Positions {
+		// currency positions are accounted by portfolio currencies
+		if position.InstrumentType == invest.InstrumentTypeCurrency {
+			continue
+		}
+		value, currency := invest.PositionValue(position)
+		price, _ := invest.PositionPrice(position)
+		averagePrice := position.AveragePositionPrice.GetValue()
+		cost := averagePrice * position.Balance
+		pnl := position.ExpectedYield.GetValue()
+		var pnlPercent float64
+		if cost != 0 {
+			pnlPercent = pnl / cost * 100
+		}
+		rows = append(rows, []interface{}{
+			account.Provider,
+			account.AccountId,
+			position.Figi,
+			position.Ticker,
+			position.Name,
+			position.Balance,
+			averagePrice,
+			price,
+			cost,
+			value,
+			pnl,
+			pnlPercent,
+			currency,
+		})
+	}
+	return rows, nil
+}
+
+// incomeRows reports dividends and coupons received in period by instrument and currency
+// together with taxes withheld from them.
+func incomeRows(ctx context.Context, provider invest.Provider, account *pb.Account, from, to string) ([][]interface{}, error) {
+
+	operations, err := loadOperations(ctx, provider, account, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	type income struct {
+		figi, currency               string
+		payments                     float64
+		dividends, coupons, withheld float64
+	}
+	var (
+		incomes []*income
+		byKey   = make(map[[2]string]*income)
+	)
+	for _, operation := range operations {
+		switch operation.OperationType {
+		case pb.OperationType_OPERATION_TYPE_DIVIDEND, pb.OperationType_OPERATION_TYPE_COUPON,
+			pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND, pb.OperationType_OPERATION_TYPE_TAX_COUPON:
+		default:
+			continue
+		}
+
+		key := [2]string{operation.Figi, operation.Currency}
+		item, found := byKey[key]
+		if !found {
+			item = &income{figi: operation.Figi, currency: operation.Currency}
+			byKey[key] = item
+			incomes = append(incomes, item)
+		}
+
+		switch operation.OperationType {
+		case pb.OperationType_OPERATION_TYPE_DIVIDEND:
+			item.payments++
+			item.dividends += operation.Payment
+		case pb.OperationType_OPERATION_TYPE_COUPON:
+			item.payments++
+			item.coupons += operation.Payment
+		default:
+			item.withheld += operation.Payment
+		}
+	}
+
+	sort.SliceStable(incomes, func(i, j int) bool {
+		if incomes[i].figi != incomes[j].figi {
+			return incomes[i].figi < incomes[j].figi
+		}
+		return incomes[i].currency < incomes[j].currency
+	})
+
+	rows := make([][]interface{}, 0, len(incomes))
+	for _, item := range incomes {
+		rows = append(rows, []interface{}{
+			account.Provider,
+			account.AccountId,
+			item.figi,
+			item.currency,
+			item.payments,
+			item.dividends,
+			item.coupons,
+			item.withheld,
+			item.dividends + item.coupons + item.withheld,
+		})
+	}
+	return rows, nil
+}
+
+func loadOperations(ctx context.Context, provider invest.Provider, account *pb.Account, from, to string) ([]*pb.Operation, error) {
+	resp, err := provider.Operations(ctx, &pb.OperationsRequest{Account: account, From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Operations, nil
+}
+
+// operationType returns short lower case name of operation type, e.g. tax_dividend.
+func operationType(operationType pb.OperationType) string {
+	return strings.ToLower(strings.TrimPrefix(operationType.String(), "OPERATION_TYPE_"))
+}
@@ -0,0 +1,36 @@
+// Package gateway serves InvestService over HTTP: REST/JSON API generated by grpc-gateway
+// and gRPC-Web for browser clients.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"goinvest/api/openapi"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"google.golang.org/grpc"
+	"net/http"
+)
+
+// Handler returns handler of REST API which calls InvestService over the client connection,
+// so requests pass the same interceptors as gRPC calls. Routes are declared in invest.proto.
+func Handler(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+
+	if conn == nil {
+		return nil, errors.New("connection provided to gateway is nil")
+	}
+	mux := runtime.NewServeMux()
+	if err := pb.RegisterInvestServiceHandler(ctx, mux, conn); err != nil {
+		return nil, fmt.Errorf("problem while registering gateway handlers: %w", err)
+	}
+	return mux, nil
+}
+
+// OpenAPIHandler serves OpenAPI document of the REST API.
+func OpenAPIHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openapi.Invest)
+	})
+}
@@ -0,0 +1,169 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testServer struct {
+	pb.UnimplementedInvestServiceServer
+}
+
+func (testServer) GetQuote(_ context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	return &pb.QuoteResponse{Quote: &pb.Quote{Figi: req.Figi, Price: 101.5}}, nil
+}
+
+func (testServer) DeleteManualAccount(_ context.Context, req *pb.DeleteManualAccountRequest) (*pb.DeleteManualAccountResponse, error) {
+	return nil, status.Error(codes.NotFound, fmt.Sprintf("account %s: %s", req.AccountId, invest.ErrNotFound))
+}
+
+func (testServer) SaveAlertRule(_ context.Context, req *pb.SaveAlertRuleRequest) (*pb.SaveAlertRuleResponse, error) {
+	return &pb.SaveAlertRuleResponse{Rule: req.Rule}, nil
+}
+
+// serve starts gRPC server and returns REST API calling it.
+func serve(t *testing.T) http.Handler {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterInvestServiceServer(server, testServer{})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	h, err := Handler(context.Background(), conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestHandler(t *testing.T) {
+
+	h := serve(t)
+	tests := []struct {
+		method, path, body string
+		code               int
+		want               string
+	}{
+		{http.MethodGet, "/v1/quotes/BBG000B9XRY4", "", http.StatusOK, `"price":101.5`},
+		{http.MethodDelete, "/v1/manual/accounts/cash", "", http.StatusNotFound, "account cash"},
+		{http.MethodPost, "/v1/alerts/rules", `{"name":"drawdown"}`, http.StatusOK, `"name":"drawdown"`},
+		{http.MethodGet, "/v1/accounts", "", http.StatusNotImplemented, "not implemented"},
+		{http.MethodGet, "/v1/unknown", "", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+		body, _ := ioutil.ReadAll(rec.Body)
+		if rec.Code != tt.code || !strings.Contains(string(body), tt.want) {
+			t.Errorf("%s %s = %d %s, want %d with %s", tt.method, tt.path, rec.Code, body, tt.code, tt.want)
+		}
+	}
+}
+
+func TestGRPCWebHandler(t *testing.T) {
+
+	var intercepted []string
+	server := grpc.NewServer(grpc.UnaryInterceptor(
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			intercepted = append(intercepted, info.FullMethod)
+			return handler(ctx, req)
+		}))
+	pb.RegisterInvestServiceServer(server, testServer{})
+	h := GRPCWebHandler(server)
+
+	msg, err := proto.Marshal(&pb.QuoteRequest{Figi: "BBG000B9XRY4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// length-prefixed message frame
+	frame := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	frame = append(frame, msg...)
+	req := httptest.NewRequest(http.MethodPost, "/invest.v1.InvestService/GetQuote", bytes.NewReader(frame))
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	body := rec.Body.Bytes()
+	if rec.Code != http.StatusOK || len(body) < 5 || body[0] != 0 {
+		t.Fatalf("response = %d %q", rec.Code, body)
+	}
+	size := int(binary.BigEndian.Uint32(body[1:5]))
+	var resp pb.QuoteResponse
+	if err := proto.Unmarshal(body[5:5+size], &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Quote.GetPrice() != 101.5 {
+		t.Errorf("quote = %v", resp.Quote)
+	}
+	// trailers frame follows the message
+	if trailers := string(body[5+size:]); !strings.Contains(trailers, "grpc-status: 0") {
+		t.Errorf("trailers = %q", trailers)
+	}
+	if len(intercepted) != 1 || intercepted[0] != "/invest.v1.InvestService/GetQuote" {
+		t.Errorf("intercepted calls = %v", intercepted)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/invest.v1.InvestService/GetQuote", nil))
+	if rec.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("plain HTTP request = %d", rec.Code)
+	}
+}
+
+// TestOpenAPIHandler fails when RPC has no HTTP route, every RPC must be reachable by REST API.
+func TestOpenAPIHandler(t *testing.T) {
+
+	rec := httptest.NewRecorder()
+	OpenAPIHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("content type = %s", ct)
+	}
+
+	var spec struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &spec); err != nil {
+		t.Fatal(err)
+	}
+	operations := map[string]bool{}
+	for _, methods := range spec.Paths {
+		for _, op := range methods {
+			operations[op.OperationID] = true
+		}
+	}
+	for _, m := range pb.InvestService_ServiceDesc.Methods {
+		if !operations["InvestService_"+m.MethodName] {
+			t.Errorf("%s has no HTTP route", m.MethodName)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package gateway
+
+import (
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+	"net/http"
+)
+
+// gRPC-Web headers which CORS of browser clients must allow and expose.
+var (
+	GRPCWebRequestHeaders  = []string{"Content-Type", "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout"}
+	GRPCWebResponseHeaders = []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"}
+)
+
+// GRPCWebHandler serves gRPC-Web calls of browser clients by the gRPC server, so calls pass its interceptors.
+// CORS is left to the router, handler serves calls only.
+func GRPCWebHandler(server *grpc.Server) http.Handler {
+
+	wrapped := grpcweb.WrapServer(server)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !wrapped.IsGrpcWebRequest(r) {
+			http.Error(w, "gRPC-Web request is expected", http.StatusUnsupportedMediaType)
+			return
+		}
+		wrapped.HandleGrpcWebRequest(w, r)
+	})
+}
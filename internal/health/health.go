@@ -0,0 +1,248 @@
+// Package health reports liveness and readiness of the server over HTTP and grpc.health.v1.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultTimeout bounds a single check.
+	defaultTimeout = 3 * time.Second
+	// defaultInterval is how often gRPC serving status is refreshed.
+	defaultInterval = 15 * time.Second
+)
+
+// Check returns error when dependency is not available.
+type Check func(ctx context.Context) error
+
+// Checker runs readiness checks of server dependencies. Failures of optional dependencies,
+// e.g. external broker APIs, degrade the server but keep it ready.
+type Checker struct {
+	mu       sync.RWMutex
+	checks   map[string]Check
+	optional map[string]bool
+	timeout  time.Duration
+	logger   *zap.Logger
+}
+
+func NewChecker(timeout time.Duration, logger *zap.Logger) (*Checker, error) {
+
+	if logger == nil {
+		return nil, errors.New("logger provided to health checker is nil")
+	}
+
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Checker{
+		checks:   make(map[string]Check),
+		optional: make(map[string]bool),
+		timeout:  timeout,
+		logger:   logger.With(zap.String("service", "health")),
+	}, nil
+}
+
+// Add registers check of the named dependency, server is not ready while it fails.
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+	delete(c.optional, name)
+}
+
+// AddOptional registers check of the named dependency, server is degraded but ready while it fails.
+func (c *Checker) AddOptional(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+	c.optional[name] = true
+}
+
+// Cached returns check which runs check at most once per interval and reports its last result in between,
+// e.g. to spare rate limits of external APIs.
+func Cached(check Check, interval time.Duration) Check {
+	var (
+		mu      sync.Mutex
+		checked time.Time
+		last    error
+	)
+	return func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if !checked.IsZero() && time.Since(checked) < interval {
+			return last
+		}
+		last, checked = check(ctx), time.Now()
+		return last
+	}
+}
+
+// Check runs all checks concurrently and returns errors of failed ones by dependency name.
+func (c *Checker) Check(ctx context.Context) map[string]error {
+
+	c.mu.RLock()
+	checks := make(map[string]Check, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	c.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed = make(map[string]error)
+	)
+	for name, check := range checks {
+		name, check := name, check
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := check(ctx); err != nil {
+				mu.Lock()
+				failed[name] = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return failed
+}
+
+// status returns overall status of failed checks and names of the failed required ones.
+func (c *Checker) status(failed map[string]error) (string, []string) {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	status := statusOK
+	var required []string
+	for name := range failed {
+		if c.optional[name] {
+			if status == statusOK {
+				status = statusDegraded
+			}
+			continue
+		}
+		status = statusUnavailable
+		required = append(required, name)
+	}
+	sort.Strings(required)
+	return status, required
+}
+
+// Report is the body of health responses.
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+const (
+	statusOK          = "ok"
+	statusDegraded    = "degraded"
+	statusUnavailable = "unavailable"
+)
+
+// LiveHandler reports that process is running.
+func (c *Checker) LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, Report{Status: statusOK})
+	})
+}
+
+// ReadyHandler reports whether dependencies are available, failed required checks respond with 503
+// and failed optional ones with 200 and degraded status.
+func (c *Checker) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		failed := c.Check(r.Context())
+
+		c.mu.RLock()
+		report := Report{Status: statusOK, Checks: make(map[string]string, len(c.checks))}
+		for name := range c.checks {
+			report.Checks[name] = statusOK
+		}
+		c.mu.RUnlock()
+
+		for name, err := range failed {
+			report.Checks[name] = err.Error()
+		}
+		code := http.StatusOK
+		if report.Status, _ = c.status(failed); report.Status == statusUnavailable {
+			code = http.StatusServiceUnavailable
+		}
+		writeReport(w, code, report)
+	})
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
+
+// UpdateGRPC sets serving status of the gRPC health server and the services from checks until context is done,
+// then marks them as not serving. Degraded server is serving. Status is refreshed every interval, fifteen seconds
+// by default, and every second while server is not ready, so it becomes serving soon after startup.
+func (c *Checker) UpdateGRPC(ctx context.Context, server *health.Server, interval time.Duration, services ...string) error {
+
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	// empty service name is the overall server status
+	services = append([]string{""}, services...)
+
+	// server is not ready on startup, it is not worth a warning
+	previous := statusUnavailable
+	update := func() healthpb.HealthCheckResponse_ServingStatus {
+		failed := c.Check(ctx)
+		report, required := c.status(failed)
+		if report != previous && report != statusOK {
+			names := make([]string, 0, len(failed))
+			for name := range failed {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			c.logger.Warn("server is "+report, zap.Strings("failed", names), zap.Strings("required", required))
+		}
+		previous = report
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if report == statusUnavailable {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+		return status
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			server.Shutdown()
+			return nil
+		case <-timer.C:
+			if update() == healthpb.HealthCheckResponse_SERVING {
+				timer.Reset(interval)
+			} else {
+				timer.Reset(time.Second)
+			}
+		}
+	}
+}
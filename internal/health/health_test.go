@@ -0,0 +1,163 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadyHandler(t *testing.T) {
+
+	checker, err := NewChecker(time.Second, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cacheDown int32
+	checker.Add("mysql", func(context.Context) error { return nil })
+	checker.Add("cache", func(context.Context) error {
+		if atomic.LoadInt32(&cacheDown) == 1 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+
+	ready := func() (int, Report) {
+		rec := httptest.NewRecorder()
+		checker.ReadyHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+		var report Report
+		if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+			t.Fatal(err)
+		}
+		return rec.Code, report
+	}
+
+	code, report := ready()
+	if code != http.StatusOK || report.Status != "ok" || report.Checks["mysql"] != "ok" || report.Checks["cache"] != "ok" {
+		t.Errorf("ready = %d %+v", code, report)
+	}
+
+	atomic.StoreInt32(&cacheDown, 1)
+	code, report = ready()
+	if code != http.StatusServiceUnavailable || report.Status != "unavailable" ||
+		report.Checks["mysql"] != "ok" || report.Checks["cache"] != "connection refused" {
+		t.Errorf("not ready = %d %+v", code, report)
+	}
+
+	// optional dependency degrades ready server
+	atomic.StoreInt32(&cacheDown, 0)
+	checker.AddOptional("provider tinkoff", func(context.Context) error { return errors.New("too many requests") })
+	code, report = ready()
+	if code != http.StatusOK || report.Status != "degraded" || report.Checks["provider tinkoff"] != "too many requests" {
+		t.Errorf("degraded = %d %+v", code, report)
+	}
+	atomic.StoreInt32(&cacheDown, 1)
+	if code, report = ready(); code != http.StatusServiceUnavailable || report.Status != "unavailable" {
+		t.Errorf("degraded and not ready = %d %+v", code, report)
+	}
+
+	rec := httptest.NewRecorder()
+	checker.LiveHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/live", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("live = %d", rec.Code)
+	}
+}
+
+func TestCached(t *testing.T) {
+
+	var calls int32
+	check := Cached(func(context.Context) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return errors.New("unavailable")
+		}
+		return nil
+	}, 50*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		if err := check(context.Background()); err == nil {
+			t.Fatal("cached failure is not reported")
+		}
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("calls = %d within interval", calls)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if err := check(context.Background()); err != nil || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("after interval error = %v, calls = %d", err, calls)
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+
+	checker, err := NewChecker(10*time.Millisecond, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	checker.Add("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if failed := checker.Check(context.Background()); !errors.Is(failed["slow"], context.DeadlineExceeded) {
+		t.Errorf("failed = %v", failed)
+	}
+}
+
+func TestUpdateGRPC(t *testing.T) {
+
+	checker, err := NewChecker(time.Second, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ready int32
+	checker.Add("server", func(context.Context) error {
+		if atomic.LoadInt32(&ready) == 0 {
+			return errors.New("starting")
+		}
+		return nil
+	})
+	// degraded server is serving
+	checker.AddOptional("provider tinkoff", func(context.Context) error { return errors.New("unavailable") })
+
+	server := health.NewServer()
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			// status of the service is not set yet
+			return healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+		return resp.Status
+	}
+	waitFor := func(want healthpb.HealthCheckResponse_ServingStatus) {
+		deadline := time.Now().Add(3 * time.Second)
+		for status("invest.v1.InvestService") != want || status("") != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("status = %s, want %s", status(""), want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- checker.UpdateGRPC(ctx, server, time.Hour, "invest.v1.InvestService") }()
+
+	waitFor(healthpb.HealthCheckResponse_NOT_SERVING)
+	// not ready server is rechecked every second
+	atomic.StoreInt32(&ready, 1)
+	waitFor(healthpb.HealthCheckResponse_SERVING)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got := status(""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("status after shutdown = %s", got)
+	}
+}
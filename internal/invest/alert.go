@@ -0,0 +1,80 @@
+package invest
+
+import (
+	"context"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"time"
+)
+
+// AlertRule is a condition on instrument price, position or portfolio which is evaluated periodically.
+type AlertRule struct {
+	ID        int64
+	Type      pb.AlertType
+	Name      string
+	Provider  string
+	AccountID string
+	Figi      string
+	// Threshold is a price for price rules and a percent for drawdown and daily change rules.
+	Threshold float64
+	Enabled   bool
+	CreatedAt time.Time
+
+	// Active reports whether condition held at the last evaluation, rule triggers again only after it is cleared.
+	Active bool
+	// Peak is the highest price observed by drawdown rule.
+	Peak float64
+	// TriggeredAt is the last time rule was triggered, zero if it never was.
+	TriggeredAt time.Time
+}
+
+// AlertEvent is a triggered alert rule.
+type AlertEvent struct {
+	ID          int64
+	RuleID      int64
+	Type        pb.AlertType
+	Provider    string
+	AccountID   string
+	Figi        string
+	Threshold   float64
+	Value       float64
+	Message     string
+	TriggeredAt time.Time
+}
+
+// AlertEventFilter selects alert events, zero fields are not applied.
+type AlertEventFilter struct {
+	RuleID    int64
+	Provider  string
+	AccountID string
+	From      time.Time
+	Limit     int
+}
+
+// PortfolioSnapshot is the last known value of account portfolio in roubles at the date.
+type PortfolioSnapshot struct {
+	Provider  string
+	AccountID string
+	Date      time.Time
+	Value     float64
+}
+
+// AlertStorage abstracts persistence of alert rules, triggered alerts and portfolio snapshots.
+type AlertStorage interface {
+	// AlertRules returns rules of account, or all rules when account is empty.
+	AlertRules(ctx context.Context, provider, accountID string) ([]AlertRule, error)
+	// SaveAlertRule creates rule when its id is zero and sets the id, otherwise replaces rule definition
+	// and resets its evaluation state. ErrNotFound is returned when rule does not exist.
+	SaveAlertRule(ctx context.Context, rule *AlertRule) error
+	// DeleteAlertRule deletes rule among with its events, ErrNotFound is returned when rule does not exist.
+	DeleteAlertRule(ctx context.Context, id int64) error
+	// UpdateAlertState saves evaluation state of rule: active flag, peak and trigger time.
+	UpdateAlertState(ctx context.Context, rule AlertRule) error
+	// SaveAlertEvent stores triggered alert and sets its id.
+	SaveAlertEvent(ctx context.Context, event *AlertEvent) error
+	// AlertEvents returns events matching filter, newest first.
+	AlertEvents(ctx context.Context, filter AlertEventFilter) ([]AlertEvent, error)
+	// SavePortfolioSnapshot creates or replaces snapshot of account at the date.
+	SavePortfolioSnapshot(ctx context.Context, snapshot PortfolioSnapshot) error
+	// PortfolioSnapshot returns the latest snapshot of account taken before the date, ErrNotFound if there is none.
+	PortfolioSnapshot(ctx context.Context, provider, accountID string, before time.Time) (PortfolioSnapshot, error)
+}
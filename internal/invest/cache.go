@@ -27,10 +27,12 @@ type Cache interface {
 
 // CacheCredentials is an option structure for configuring real cache implementation.
 type CacheCredentials struct {
-	Active string `yaml:"active"`
-	Redis  struct {
-		Address  string `yaml:"address"`
-		Password string `yaml:"password"`
-		PoolSize int    `yaml:"poolSize"`
+	Active string `yaml:"active" validate:"omitempty,oneof=redis"`
+	// TTLs override expiration of values by key prefix, e.g. "tinkoff:instrument:": 12h, they are applied on reload.
+	TTLs  map[string]time.Duration `yaml:"ttls" validate:"dive,gt=0"`
+	Redis struct {
+		Address  string `yaml:"address" validate:"required"`
+		Password string `yaml:"password" secret:"true"`
+		PoolSize int    `yaml:"poolSize" validate:"gte=0"`
 	} `yaml:"redis"`
 }
@@ -0,0 +1,24 @@
+package invest
+
+import (
+	"context"
+)
+
+// Instrument holds descriptive metadata of instrument which brokers do not provide, such as sector or issuer.
+type Instrument struct {
+	Figi    string
+	Ticker  string
+	Isin    string
+	Name    string
+	Sector  string
+	Country string
+	Issuer  string
+}
+
+// InstrumentStorage abstracts instrument metadata persistence.
+type InstrumentStorage interface {
+	// Instruments returns metadata of known instruments by figi, unknown figis are skipped.
+	Instruments(ctx context.Context, figis []string) (map[string]Instrument, error)
+	// SaveInstrument creates or replaces instrument metadata.
+	SaveInstrument(ctx context.Context, instrument Instrument) error
+}
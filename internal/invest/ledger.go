@@ -0,0 +1,85 @@
+package invest
+
+import (
+	"context"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"time"
+)
+
+// LedgerAccount is an account of provider without API, which data is imported from statements or entered manually.
+type LedgerAccount struct {
+	Provider    ProviderID
+	AccountID   string
+	AccountType pb.AccountType
+	Name        string
+}
+
+// LedgerPosition is a position snapshot of ledger account. Cash balances are stored as positions
+// of InstrumentTypeCurrency with currency code as ticker.
+type LedgerPosition struct {
+	Figi           string
+	Ticker         string
+	Isin           string
+	Name           string
+	InstrumentType string
+	Balance        float64
+	// AveragePrice is an average purchase price of a single unit.
+	AveragePrice float64
+	// Price is a last known market price of a single unit, average price is used when it is unknown.
+	Price    float64
+	Currency string
+}
+
+// Key identifies position within account by the first known of figi, isin and ticker.
+func (p LedgerPosition) Key() string {
+	for _, key := range []string{p.Figi, p.Isin, p.Ticker} {
+		if key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+// LedgerOperation is an operation of ledger account.
+type LedgerOperation struct {
+	ID             string
+	OperationType  pb.OperationType
+	Figi           string
+	Ticker         string
+	Isin           string
+	InstrumentType string
+	Date           time.Time
+	Quantity       int32
+	Price          float64
+	Payment        float64
+	Currency       string
+	Commission     float64
+}
+
+// LedgerStorage abstracts persistence of accounts, positions and operations of providers without API.
+type LedgerStorage interface {
+	// LedgerAccounts returns accounts of provider.
+	LedgerAccounts(ctx context.Context, provider ProviderID) ([]LedgerAccount, error)
+	// SaveLedgerAccount creates or replaces account.
+	SaveLedgerAccount(ctx context.Context, account LedgerAccount) error
+	// DeleteLedgerAccount deletes account among with its positions and operations.
+	DeleteLedgerAccount(ctx context.Context, provider ProviderID, accountID string) error
+	// LedgerPositions returns positions of account.
+	LedgerPositions(ctx context.Context, provider ProviderID, accountID string) ([]LedgerPosition, error)
+	// ReplaceLedgerPositions replaces all positions of account with a new snapshot.
+	ReplaceLedgerPositions(ctx context.Context, provider ProviderID, accountID string, positions []LedgerPosition) error
+	// SaveLedgerPosition creates or replaces a single position of account by its key.
+	SaveLedgerPosition(ctx context.Context, provider ProviderID, accountID string, position LedgerPosition) error
+	// DeleteLedgerPosition deletes position of account by its key.
+	DeleteLedgerPosition(ctx context.Context, provider ProviderID, accountID string, key string) error
+	// LedgerOperations returns operations of account within [from, to) period ordered by date.
+	LedgerOperations(ctx context.Context, provider ProviderID, accountID string, from, to time.Time) ([]LedgerOperation, error)
+	// SaveLedgerOperations creates or replaces operations of account by their ids.
+	SaveLedgerOperations(ctx context.Context, provider ProviderID, accountID string, operations []LedgerOperation) error
+}
+
+// Importer is implemented by providers which ingest broker statements.
+type Importer interface {
+	// Import parses statement and stores its accounts, positions and operations.
+	Import(ctx context.Context, request *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error)
+}
@@ -0,0 +1,83 @@
+package invest
+
+import (
+	"context"
+	"time"
+)
+
+// Notification kinds, channel receives notifications of subscribed kinds only.
+const (
+	NotificationAlert     = "alert"
+	NotificationStatement = "statement"
+)
+
+// Notification is a message delivered to users over their notification channels.
+type Notification struct {
+	Kind    string
+	Subject string
+	Text    string
+	// Data is a structured payload, it is sent as JSON by channels which support it.
+	Data interface{}
+}
+
+// Notifier delivers notifications to subscribed channels.
+type Notifier interface {
+	// Notify delivers notification to every enabled channel subscribed to its kind.
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// NotificationChannel is a destination of notifications, e.g. webhook url, email address or telegram chat.
+// Alerts and statements have no owner, so every channel subscribed to a kind receives all its notifications.
+type NotificationChannel struct {
+	ID int64
+	// User is a label to list and manage channels by, it does not scope notifications channel receives.
+	User   string
+	Type   string
+	Target string
+	// Secret signs webhook payloads.
+	Secret string
+	// Kinds are notification kinds channel is subscribed to, empty means all.
+	Kinds     []string
+	Enabled   bool
+	CreatedAt time.Time
+}
+
+// Subscribed reports whether channel receives notifications of the kind.
+func (c NotificationChannel) Subscribed(kind string) bool {
+	if len(c.Kinds) == 0 {
+		return true
+	}
+	for _, k := range c.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// DeadLetter is a notification which was not delivered after all attempts.
+type DeadLetter struct {
+	ID          int64
+	ChannelID   int64
+	ChannelType string
+	Target      string
+	Kind        string
+	Subject     string
+	Text        string
+	Error       string
+	Attempts    int
+	CreatedAt   time.Time
+}
+
+// NotificationStorage abstracts persistence of notification channels and undelivered notifications.
+type NotificationStorage interface {
+	// NotificationChannels returns channels of user, or channels of all users when user is empty.
+	NotificationChannels(ctx context.Context, user string) ([]NotificationChannel, error)
+	// SaveNotificationChannel creates channel when its id is zero and sets the id, otherwise replaces it.
+	// ErrNotFound is returned when channel does not exist.
+	SaveNotificationChannel(ctx context.Context, channel *NotificationChannel) error
+	// DeleteNotificationChannel deletes channel, ErrNotFound is returned when channel does not exist.
+	DeleteNotificationChannel(ctx context.Context, id int64) error
+	// SaveDeadLetter stores undelivered notification and sets its id.
+	SaveDeadLetter(ctx context.Context, letter *DeadLetter) error
+}
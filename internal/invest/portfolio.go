@@ -0,0 +1,37 @@
+package invest
+
+import (
+	pb "goinvest/gen/proto/go/invest/v1"
+)
+
+// InstrumentTypeCurrency is instrument type of currency positions, such positions are duplicated
+// by portfolio currency balances.
+const InstrumentTypeCurrency = "Currency"
+
+// PositionValue returns current market value of position among with its currency.
+// Brokers report average price and expected yield, so value is their sum.
+func PositionValue(position *pb.Position) (value float64, currency string) {
+	if avg := position.AveragePositionPrice; avg != nil {
+		value = avg.Value * position.Balance
+		currency = avg.Currency
+	}
+	if yield := position.ExpectedYield; yield != nil {
+		value += yield.Value
+		if currency == "" {
+			currency = yield.Currency
+		}
+	}
+	return value, currency
+}
+
+// PositionPrice returns current market price of a single position unit.
+func PositionPrice(position *pb.Position) (price float64, currency string) {
+	value, currency := PositionValue(position)
+	if position.Balance == 0 {
+		if avg := position.AveragePositionPrice; avg != nil {
+			return avg.Value, currency
+		}
+		return 0, currency
+	}
+	return value / position.Balance, currency
+}
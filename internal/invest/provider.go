@@ -2,32 +2,126 @@ package invest
 
 import (
 	"context"
+	"fmt"
 	pb "goinvest/gen/proto/go/invest/v1"
+	"strconv"
 )
 
 // ProviderID assert for provider id
 type ProviderID uint64
 
-const (
-	// ProviderTinkoff tinkoff provider
-	ProviderTinkoff ProviderID = 1
-)
-
 // Uint32 return uint32 for provider id
 func (p ProviderID) Uint32() uint32 { return uint32(p) }
 
+// String returns name the provider was registered with
+func (p ProviderID) String() string {
+	registry.RLock()
+	defer registry.RUnlock()
+	for name, registration := range registry.providers {
+		if registration.id == p {
+			return name
+		}
+	}
+	return "unknown"
+}
+
 type Provider interface {
 	// Portfolio retrieves portfolio info
 	Portfolio(ctx context.Context, request *pb.PortfolioRequest) (*pb.PortfolioResponse, error)
 	// Accounts retrieves portfolio info
 	Accounts(ctx context.Context, request *pb.AccountsRequest) (*pb.AccountsResponse, error)
+	// Operations retrieves account operations for the requested period
+	Operations(ctx context.Context, request *pb.OperationsRequest) (*pb.OperationsResponse, error)
+	// Quote retrieves last price and lot size of instrument
+	Quote(ctx context.Context, request *pb.QuoteRequest) (*pb.QuoteResponse, error)
+}
+
+// UnwrapProvider returns provider without its wrappers, such as rate limit, metrics and tracing,
+// wrappers implement Unwrap returning the wrapped provider.
+func UnwrapProvider(provider Provider) Provider {
+	for {
+		wrapper, ok := provider.(interface{ Unwrap() Provider })
+		if !ok {
+			return provider
+		}
+		provider = wrapper.Unwrap()
+	}
+}
+
+// WrapProvider returns wrapper of inner provider which is an Importer only when inner provider is,
+// so wrappers implement Import delegating to inner provider and stay transparent to type assertions.
+func WrapProvider(inner, wrapped Provider) Provider {
+	if _, ok := inner.(Importer); ok {
+		return wrapped
+	}
+	return providerOnly{Provider: wrapped}
+}
+
+// providerOnly hides methods of wrapper except Provider ones.
+type providerOnly struct {
+	Provider
+}
+
+// Unwrap returns the hidden wrapper.
+func (p providerOnly) Unwrap() Provider {
+	return p.Provider
 }
 
 // ProvidersConfig config for providers
 type ProvidersConfig struct {
+	// List of providers to construct by their registered names.
+	List []ProviderConfig `yaml:"list" validate:"dive"`
+	// Tinkoff is a legacy tinkoff provider config, it is used only when list is empty.
 	Tinkoff struct {
-		TokenSandbox string `yaml:"tokenSandbox"`
-		Token        string `yaml:"token"`
-		Rps          int    `yaml:"rpc"`
-	} `yaml:"google"`
+		TokenSandbox string `yaml:"tokenSandbox" secret:"true"`
+		Token        string `yaml:"token" secret:"true"`
+		Rps          int    `yaml:"rps" validate:"gte=0"`
+	} `yaml:"tinkoff"`
+}
+
+// ProviderConfig configures a single provider instance.
+type ProviderConfig struct {
+	Name        string            `yaml:"name" validate:"required"`
+	Enabled     bool              `yaml:"enabled"`
+	Credentials map[string]string `yaml:"credentials" secret:"true"`
+	Options     map[string]string `yaml:"options"`
+}
+
+// Enabled returns configs of enabled providers, falling back to legacy tinkoff config
+// when providers list is empty.
+func (c *ProvidersConfig) Enabled() []ProviderConfig {
+	if len(c.List) == 0 {
+		return []ProviderConfig{{
+			Name:    "tinkoff",
+			Enabled: true,
+			Credentials: map[string]string{
+				"token":         c.Tinkoff.Token,
+				"sandbox_token": c.Tinkoff.TokenSandbox,
+			},
+			Options: map[string]string{
+				"rps": strconv.Itoa(c.Tinkoff.Rps),
+			},
+		}}
+	}
+
+	enabled := make([]ProviderConfig, 0, len(c.List))
+	for _, conf := range c.List {
+		if conf.Enabled {
+			enabled = append(enabled, conf)
+		}
+	}
+	return enabled
+}
+
+// IntOption returns integer option by key or default value when option is not set.
+func (c ProviderConfig) IntOption(key string, defaultValue int) (int, error) {
+	value, found := c.Options[key]
+	if !found || value == "" {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("provider %s: option %s must be integer: %w", c.Name, key, err)
+	}
+	return i, nil
 }
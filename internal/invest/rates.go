@@ -0,0 +1,49 @@
+package invest
+
+import (
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"strings"
+)
+
+// CurrencyRUB is the reporting currency, all rates are given in roubles.
+const CurrencyRUB = "RUB"
+
+// Rates holds rouble exchange rates by currency code, rouble itself is implied.
+type Rates map[string]float64
+
+// NewRates collects rates passed in a request.
+func NewRates(pbRates []*pb.CurrencyRate) Rates {
+	rates := make(Rates, len(pbRates))
+	for _, rate := range pbRates {
+		rates[rate.Currency] = rate.Rate
+	}
+	return rates
+}
+
+// RatesFromConfig collects rates from config, currency codes are uppercased since viper lowercases map keys.
+func RatesFromConfig(conf map[string]float64) Rates {
+	rates := make(Rates, len(conf))
+	for currency, rate := range conf {
+		rates[strings.ToUpper(currency)] = rate
+	}
+	return rates
+}
+
+// ToRUB converts value in the given currency to roubles,
+// returns ErrInvalidArgument when rate for the currency is unknown.
+func (r Rates) ToRUB(value float64, currency string) (float64, error) {
+	if !IsForeignCurrency(currency) {
+		return value, nil
+	}
+	rate, found := r[currency]
+	if !found || rate <= 0 {
+		return 0, fmt.Errorf("%w: rouble rate for currency %s is not provided", ErrInvalidArgument, currency)
+	}
+	return value * rate, nil
+}
+
+// IsForeignCurrency reports whether currency is set and differs from rouble.
+func IsForeignCurrency(currency string) bool {
+	return currency != "" && currency != CurrencyRUB
+}
@@ -0,0 +1,73 @@
+package invest
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"sort"
+	"sync"
+)
+
+// ProviderDependencies are shared dependencies passed to every provider factory.
+type ProviderDependencies struct {
+	Storage Storage
+	Cache   Cache
+	Logger  *zap.Logger
+}
+
+// ProviderFactory constructs provider from its config.
+type ProviderFactory func(conf ProviderConfig, deps ProviderDependencies) (Provider, error)
+
+type providerRegistration struct {
+	id      ProviderID
+	factory ProviderFactory
+}
+
+var registry = struct {
+	sync.RWMutex
+	providers map[string]providerRegistration
+}{
+	providers: make(map[string]providerRegistration),
+}
+
+// RegisterProvider makes provider factory available by name, it is meant to be called from init
+// function of provider package. It panics if name or id is registered twice or factory is nil.
+func RegisterProvider(name string, id ProviderID, factory ProviderFactory) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if factory == nil {
+		panic("invest: register provider factory is nil")
+	}
+	if _, found := registry.providers[name]; found {
+		panic(fmt.Sprintf("invest: register provider called twice for name %s", name))
+	}
+	for registered, registration := range registry.providers {
+		if registration.id == id {
+			panic(fmt.Sprintf("invest: provider id %d is already registered by %s", id, registered))
+		}
+	}
+
+	registry.providers[name] = providerRegistration{id: id, factory: factory}
+}
+
+// RegisteredProvider returns id and factory of provider registered by name.
+func RegisteredProvider(name string) (ProviderID, ProviderFactory, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	registration, found := registry.providers[name]
+	return registration.id, registration.factory, found
+}
+
+// RegisteredProviders returns sorted names of registered providers.
+func RegisteredProviders() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	names := make([]string, 0, len(registry.providers))
+	for name := range registry.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
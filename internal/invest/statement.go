@@ -0,0 +1,32 @@
+package invest
+
+import (
+	"context"
+	"time"
+)
+
+// Statement is a rendered periodic statement of an account.
+type Statement struct {
+	ID        int64
+	Provider  string
+	AccountID string
+	// Period is statement period kind, month or quarter, covering [From, To).
+	Period      string
+	From        time.Time
+	To          time.Time
+	Format      string
+	Filename    string
+	ContentType string
+	Content     []byte
+	CreatedAt   time.Time
+}
+
+// StatementStorage abstracts persistence of generated statements.
+type StatementStorage interface {
+	// SaveStatement stores statement and sets its id.
+	SaveStatement(ctx context.Context, statement *Statement) error
+	// Statements returns statements of account without content, newest periods first.
+	Statements(ctx context.Context, provider, accountID string) ([]Statement, error)
+	// Statement returns statement with content by id, ErrNotFound if it does not exist.
+	Statement(ctx context.Context, id int64) (Statement, error)
+}
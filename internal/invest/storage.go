@@ -10,4 +10,11 @@ var ErrNotFound = errors.New("record was not found in database")
 
 // Storage abstracts database interactions for entities.
 type Storage interface {
+	InstrumentStorage
+	TargetStorage
+	LedgerStorage
+	StatementStorage
+	AlertStorage
+	NotificationStorage
+	UserStorage
 }
@@ -0,0 +1,19 @@
+package invest
+
+import (
+	"context"
+)
+
+// TargetWeight is desired share of instrument in account portfolio, the rest of portfolio is kept in cash.
+type TargetWeight struct {
+	Figi   string
+	Weight float64
+}
+
+// TargetStorage abstracts persistence of target model portfolios.
+type TargetStorage interface {
+	// TargetWeights returns target weights of provider account, empty list if targets were not set.
+	TargetWeights(ctx context.Context, provider, accountID string) ([]TargetWeight, error)
+	// SaveTargetWeights replaces target weights of provider account.
+	SaveTargetWeights(ctx context.Context, provider, accountID string, weights []TargetWeight) error
+}
@@ -1,4 +1,30 @@
 package invest
 
+import (
+	"context"
+	"time"
+)
+
+// User is a person using the service, it is identified by name and may be linked to a Telegram account.
 type User struct {
+	ID   int64
+	Name string
+	// TelegramID is id of linked Telegram user, zero when account is not linked.
+	TelegramID int64
+	// LinkCode is a one-time code which links Telegram account to user until LinkExpiresAt.
+	LinkCode      string
+	LinkExpiresAt time.Time
+	CreatedAt     time.Time
+}
+
+// UserStorage abstracts persistence of users.
+type UserStorage interface {
+	// User returns user by name, ErrNotFound if there is none.
+	User(ctx context.Context, name string) (User, error)
+	// UserByTelegramID returns user linked to Telegram account, ErrNotFound if there is none.
+	UserByTelegramID(ctx context.Context, telegramID int64) (User, error)
+	// UserByLinkCode returns user with the link code, ErrNotFound if there is none.
+	UserByLinkCode(ctx context.Context, code string) (User, error)
+	// SaveUser creates user when its id is zero and sets the id, otherwise updates it.
+	SaveUser(ctx context.Context, user *User) error
 }
@@ -1,5 +1,10 @@
 package invest
 
+import "errors"
+
+// ErrInvalidArgument is returned (wrapped) when request does not pass validation.
+var ErrInvalidArgument = errors.New("invalid argument")
+
 // Validator validates types
 type Validator interface {
 	Validate() error
@@ -0,0 +1,224 @@
+// Package lifecycle starts server components in order and stops them in reverse order.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCloseTimeout bounds shutdown of all components when timeout is not configured.
+const DefaultCloseTimeout = 5 * time.Second
+
+// Component is an independently managed part of the server, such as a listener or a background job.
+type Component struct {
+	Name string
+	// Start prepares component synchronously, e.g. binds listener, optional. Failure aborts startup.
+	Start func(ctx context.Context) error
+	// Run serves until Stop is called or context of component is cancelled.
+	Run func(ctx context.Context) error
+	// Stop gracefully stops Run within context deadline, optional. Context of Run is cancelled after it.
+	Stop func(ctx context.Context) error
+}
+
+// Manager runs components.
+type Manager struct {
+	logger     *zap.Logger
+	components []Component
+	ready      int32
+}
+
+func New(logger *zap.Logger) (*Manager, error) {
+
+	if logger == nil {
+		return nil, errors.New("logger provided to lifecycle manager is nil")
+	}
+
+	return &Manager{logger: logger}, nil
+}
+
+// Add appends component, components start in order of addition.
+func (m *Manager) Add(c Component) {
+	m.components = append(m.components, c)
+}
+
+// Ready reports whether all components are started and shutdown has not begun.
+func (m *Manager) Ready() bool {
+	return atomic.LoadInt32(&m.ready) == 1
+}
+
+type running struct {
+	Component
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+// Run starts components and waits until context is done or any component fails, then stops
+// components in reverse order within closeTimeout. Error of the failed component is returned.
+func (m *Manager) Run(ctx context.Context, closeTimeout time.Duration) error {
+
+	if closeTimeout <= 0 {
+		closeTimeout = DefaultCloseTimeout
+	}
+
+	var (
+		started []*running
+		failed  = make(chan *running, len(m.components))
+		runErr  error
+	)
+	for _, c := range m.components {
+		if c.Start != nil {
+			if err := c.Start(ctx); err != nil {
+				runErr = fmt.Errorf("start %s: %w", c.Name, err)
+				break
+			}
+		}
+
+		rctx, cancel := context.WithCancel(context.Background())
+		r := &running{Component: c, cancel: cancel, done: make(chan struct{})}
+		started = append(started, r)
+		m.logger.Info("starting component", zap.String("component", c.Name))
+		go func() {
+			defer close(r.done)
+			if r.err = r.Run(rctx); r.err != nil {
+				failed <- r
+			}
+		}()
+	}
+
+	if runErr == nil {
+		atomic.StoreInt32(&m.ready, 1)
+		m.logger.Info("all components started")
+		select {
+		case <-ctx.Done():
+			m.logger.Info("shutting down")
+		case r := <-failed:
+			runErr = fmt.Errorf("%s: %w", r.Name, r.err)
+			m.logger.Error("component failed, shutting down", zap.String("component", r.Name), zap.Error(r.err))
+		}
+	}
+	atomic.StoreInt32(&m.ready, 0)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	var stopErrs []error
+	for i := len(started) - 1; i >= 0; i-- {
+		if err := m.stop(shutdownCtx, started[i]); err != nil {
+			m.logger.Error("problem while stopping component", zap.String("component", started[i].Name), zap.Error(err))
+			stopErrs = append(stopErrs, err)
+		}
+	}
+
+	if runErr != nil {
+		return runErr
+	}
+	if len(stopErrs) > 0 {
+		return stopErrs[0]
+	}
+	m.logger.Info("server shutdown gracefully")
+	return nil
+}
+
+func (m *Manager) stop(ctx context.Context, r *running) error {
+
+	var err error
+	if r.Stop != nil {
+		if err = r.Stop(ctx); err != nil {
+			err = fmt.Errorf("stop %s: %w", r.Name, err)
+		}
+	}
+	r.cancel()
+
+	select {
+	case <-r.done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%s did not stop in time: %w", r.Name, ctx.Err())
+	}
+}
+
+// HTTPServer returns component serving HTTP on the server address, HTTPS when server has TLS config.
+func HTTPServer(name string, server *http.Server) Component {
+
+	var lis net.Listener
+	return Component{
+		Name: name,
+		Start: func(context.Context) (err error) {
+			lis, err = net.Listen("tcp", server.Addr)
+			return err
+		},
+		Run: func(context.Context) error {
+			var err error
+			if server.TLSConfig != nil {
+				// certificates are taken from TLS config
+				err = server.ServeTLS(lis, "", "")
+			} else {
+				err = server.Serve(lis)
+			}
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			if err := server.Shutdown(ctx); err != nil {
+				// connections which are still active are closed forcibly
+				_ = server.Close()
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GRPCServer returns component serving gRPC on the address. Calls in flight are cancelled
+// when they do not complete before shutdown deadline.
+func GRPCServer(name, addr string, server *grpc.Server) Component {
+
+	var lis net.Listener
+	return Component{
+		Name: name,
+		Start: func(context.Context) (err error) {
+			lis, err = net.Listen("tcp", addr)
+			return err
+		},
+		Run: func(context.Context) error {
+			return server.Serve(lis)
+		},
+		Stop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				server.Stop()
+				return ctx.Err()
+			}
+		},
+	}
+}
+
+// Job returns component running until its context is cancelled, such as a background worker.
+func Job(name string, run func(ctx context.Context) error) Component {
+	return Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			if err := run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				return err
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder records order of component events.
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) add(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var s string
+	for i, e := range r.events {
+		if i > 0 {
+			s += ", "
+		}
+		s += e
+	}
+	return s
+}
+
+func recorded(rec *recorder, name string) Component {
+	return Component{
+		Name:  name,
+		Start: func(context.Context) error { rec.add("start " + name); return nil },
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			rec.add("done " + name)
+			return nil
+		},
+		Stop: func(context.Context) error { rec.add("stop " + name); return nil },
+	}
+}
+
+func newManager(t *testing.T) *Manager {
+	m, err := New(zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestOrder(t *testing.T) {
+
+	var rec recorder
+	m := newManager(t)
+	m.Add(recorded(&rec, "a"))
+	m.Add(recorded(&rec, "b"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- m.Run(ctx, time.Second) }()
+
+	deadline := time.Now().Add(time.Second)
+	for !m.Ready() {
+		if time.Now().After(deadline) {
+			t.Fatal("manager is not ready")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if m.Ready() {
+		t.Error("manager is ready after shutdown")
+	}
+
+	want := "start a, start b, stop b, done b, stop a, done a"
+	if got := rec.String(); got != want {
+		t.Errorf("events = %s, want %s", got, want)
+	}
+}
+
+func TestFailures(t *testing.T) {
+
+	var (
+		rec    recorder
+		errRun = errors.New("run failed")
+	)
+	m := newManager(t)
+	m.Add(recorded(&rec, "a"))
+	m.Add(Job("job", func(context.Context) error { return errRun }))
+	if err := m.Run(context.Background(), time.Second); !errors.Is(err, errRun) {
+		t.Errorf("Run() error = %v, want %v", err, errRun)
+	}
+	if got, want := rec.String(), "start a, stop a, done a"; got != want {
+		t.Errorf("events after run failure = %s, want %s", got, want)
+	}
+
+	rec = recorder{}
+	errStart := errors.New("start failed")
+	m = newManager(t)
+	m.Add(recorded(&rec, "a"))
+	m.Add(Component{Name: "b", Start: func(context.Context) error { return errStart }})
+	m.Add(recorded(&rec, "c"))
+	if err := m.Run(context.Background(), time.Second); !errors.Is(err, errStart) {
+		t.Errorf("Run() error = %v, want %v", err, errStart)
+	}
+	if got, want := rec.String(), "start a, stop a, done a"; got != want {
+		t.Errorf("events after start failure = %s, want %s", got, want)
+	}
+
+	m = newManager(t)
+	m.Add(Component{Name: "stuck", Run: func(context.Context) error { select {} }})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := m.Run(ctx, 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Run() error = %v, want deadline exceeded", err)
+	}
+}
+
+func TestServers(t *testing.T) {
+
+	addr := func() string {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer lis.Close()
+		return lis.Addr().String()
+	}
+	httpAddr, grpcAddr := addr(), addr()
+
+	m := newManager(t)
+	m.Add(HTTPServer("http", &http.Server{Addr: httpAddr, Handler: http.NotFoundHandler()}))
+	grpcServer := grpc.NewServer()
+	pb.RegisterInvestServiceServer(grpcServer, pb.UnimplementedInvestServiceServer{})
+	m.Add(GRPCServer("grpc", grpcAddr, grpcServer))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- m.Run(ctx, time.Second) }()
+	deadline := time.Now().Add(time.Second)
+	for !m.Ready() {
+		if time.Now().After(deadline) {
+			t.Fatal("manager is not ready")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// both servers serve at the same time
+	resp, err := http.Get("http://" + httpAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	conn, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_, err = pb.NewInvestServiceClient(conn).GetAccounts(context.Background(), &pb.AccountsRequest{})
+	if err == nil || status.Code(err) != codes.Unimplemented {
+		t.Errorf("grpc call error = %v, want unimplemented", err)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := net.Dial("tcp", httpAddr); err == nil {
+		t.Error("http server listens after shutdown")
+	}
+	if _, err := net.Dial("tcp", grpcAddr); err == nil {
+		t.Error("grpc server listens after shutdown")
+	}
+}
+
+func TestHTTPServerTLS(t *testing.T) {
+
+	tlsConf, err := server.LoadTLS(server.TLSConfig{Enabled: true, SelfSigned: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	c := HTTPServer("https", &http.Server{Addr: addr, TLSConfig: tlsConf, Handler: http.NotFoundHandler()})
+	if err := c.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- c.Run(context.Background()) }()
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: server.ClientTLS(tlsConf)}}
+	resp, err := client.Get("https://" + addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound || resp.TLS == nil {
+		t.Errorf("response = %d, tls %v", resp.StatusCode, resp.TLS != nil)
+	}
+	client.CloseIdleConnections()
+
+	if err := c.Stop(context.Background()); err != nil {
+		t.Error(err)
+	}
+	if err := <-done; err != nil {
+		t.Error(err)
+	}
+}
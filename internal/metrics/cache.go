@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// InstrumentCache wraps cache to observe duration, hits, misses and errors of its operations.
+func InstrumentCache(cache invest.Cache) invest.Cache {
+	return &instrumentedCache{cache: cache}
+}
+
+type instrumentedCache struct {
+	cache invest.Cache
+}
+
+func (c *instrumentedCache) Get(ctx context.Context, key string, ptrValue interface{}) error {
+
+	start := time.Now()
+	err := c.cache.Get(ctx, key, ptrValue)
+	outcome := OutcomeHit
+	switch {
+	case errors.Is(err, invest.ErrCacheMiss):
+		outcome = OutcomeMiss
+	case err != nil:
+		outcome = OutcomeError
+		cacheErrors.WithLabelValues("get", ErrorClass(err)).Inc()
+	}
+	cacheDuration.WithLabelValues("get", outcome).Observe(time.Since(start).Seconds())
+	return err
+}
+
+func (c *instrumentedCache) Set(ctx context.Context, key string, ptrValue interface{}, expires time.Duration) error {
+
+	start := time.Now()
+	err := c.cache.Set(ctx, key, ptrValue, expires)
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeError
+		cacheErrors.WithLabelValues("set", ErrorClass(err)).Inc()
+	}
+	cacheDuration.WithLabelValues("set", outcome).Observe(time.Since(start).Seconds())
+	return err
+}
@@ -0,0 +1,176 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Grafana dashboard model, only fields the dashboard uses.
+type (
+	dashboard struct {
+		UID           string     `json:"uid"`
+		Title         string     `json:"title"`
+		Tags          []string   `json:"tags"`
+		Timezone      string     `json:"timezone"`
+		SchemaVersion int        `json:"schemaVersion"`
+		Refresh       string     `json:"refresh"`
+		Time          timeRange  `json:"time"`
+		Templating    templating `json:"templating"`
+		Panels        []panel    `json:"panels"`
+	}
+
+	timeRange struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+
+	templating struct {
+		List []variable `json:"list"`
+	}
+
+	variable struct {
+		Name  string `json:"name"`
+		Label string `json:"label"`
+		Type  string `json:"type"`
+		Query string `json:"query"`
+	}
+
+	panel struct {
+		ID          int         `json:"id"`
+		Title       string      `json:"title"`
+		Type        string      `json:"type"`
+		Datasource  string      `json:"datasource"`
+		GridPos     gridPos     `json:"gridPos"`
+		FieldConfig fieldConfig `json:"fieldConfig"`
+		Targets     []target    `json:"targets"`
+	}
+
+	gridPos struct {
+		H int `json:"h"`
+		W int `json:"w"`
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	fieldConfig struct {
+		Defaults struct {
+			Unit string `json:"unit"`
+		} `json:"defaults"`
+	}
+
+	target struct {
+		RefID        string `json:"refId"`
+		Expr         string `json:"expr"`
+		LegendFormat string `json:"legendFormat"`
+	}
+)
+
+// panelSpec describes panel of the dashboard.
+type panelSpec struct {
+	title  string
+	unit   string
+	expr   string
+	legend string
+}
+
+func rate(metric string) string {
+	return fmt.Sprintf("rate(%s[$__rate_interval])", metric)
+}
+
+func p95(metric, by string) string {
+	return fmt.Sprintf("histogram_quantile(0.95, sum by (le, %s) (%s))", by, rate(metric+"_bucket"))
+}
+
+var panels = []panelSpec{
+	{
+		title:  "Provider requests",
+		unit:   "reqps",
+		expr:   fmt.Sprintf("sum by (provider, method, outcome) (%s)", rate(ProviderRequestDuration+"_count")),
+		legend: "{{provider}} {{method}} {{outcome}}",
+	},
+	{
+		title:  "Provider latency p95",
+		unit:   "s",
+		expr:   p95(ProviderRequestDuration, "provider, method"),
+		legend: "{{provider}} {{method}}",
+	},
+	{
+		title:  "Provider errors",
+		unit:   "reqps",
+		expr:   fmt.Sprintf("sum by (provider, method, class) (%s)", rate(ProviderErrorsTotal)),
+		legend: "{{provider}} {{method}} {{class}}",
+	},
+	{
+		title: "Cache hit ratio",
+		unit:  "percentunit",
+		expr: fmt.Sprintf(`sum(%s) / sum(%s)`,
+			rate(CacheOperationDuration+`_count{operation="get", outcome="hit"}`),
+			rate(CacheOperationDuration+`_count{operation="get"}`)),
+		legend: "hit ratio",
+	},
+	{
+		title:  "Cache latency p95",
+		unit:   "s",
+		expr:   p95(CacheOperationDuration, "operation"),
+		legend: "{{operation}}",
+	},
+	{
+		title:  "Cache errors",
+		unit:   "ops",
+		expr:   fmt.Sprintf("sum by (operation, class) (%s)", rate(CacheErrorsTotal)),
+		legend: "{{operation}} {{class}}",
+	},
+	{
+		title:  "API errors",
+		unit:   "reqps",
+		expr:   fmt.Sprintf("sum by (method, class) (%s)", rate(APIErrorsTotal)),
+		legend: "{{method}} {{class}}",
+	},
+	{
+		title:  "gRPC requests",
+		unit:   "reqps",
+		expr:   fmt.Sprintf("sum by (grpc_method, grpc_code) (%s)", rate("grpc_server_handled_total")),
+		legend: "{{grpc_method}} {{grpc_code}}",
+	},
+}
+
+// Dashboard returns Grafana dashboard JSON with provider, cache and API panels.
+func Dashboard() ([]byte, error) {
+
+	const (
+		width  = 12
+		height = 8
+	)
+
+	d := dashboard{
+		UID:           "goinvest",
+		Title:         "Invest",
+		Tags:          []string{"goinvest"},
+		Timezone:      "browser",
+		SchemaVersion: 30,
+		Refresh:       "30s",
+		Time:          timeRange{From: "now-6h", To: "now"},
+		Templating: templating{List: []variable{
+			{Name: "datasource", Label: "Data source", Type: "datasource", Query: "prometheus"},
+		}},
+	}
+	for i, spec := range panels {
+		p := panel{
+			ID:         i + 1,
+			Title:      spec.title,
+			Type:       "timeseries",
+			Datasource: "${datasource}",
+			// two panels in a row
+			GridPos: gridPos{H: height, W: width, X: i % 2 * width, Y: i / 2 * height},
+			Targets: []target{{RefID: "A", Expr: spec.expr, LegendFormat: spec.legend}},
+		}
+		p.FieldConfig.Defaults.Unit = spec.unit
+		d.Panels = append(d.Panels, p)
+	}
+
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal dashboard: %w", err)
+	}
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,120 @@
+// Package metrics instruments providers, cache and API errors with Prometheus metrics.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"goinvest/internal/invest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+	"time"
+)
+
+// Metric names, the dashboard queries them.
+const (
+	ProviderRequestDuration = "invest_provider_request_duration_seconds"
+	ProviderErrorsTotal     = "invest_provider_errors_total"
+	CacheOperationDuration  = "invest_cache_operation_duration_seconds"
+	CacheErrorsTotal        = "invest_cache_errors_total"
+	APIErrorsTotal          = "invest_api_errors_total"
+)
+
+// Outcomes of provider calls and cache operations.
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+	OutcomeHit     = "hit"
+	OutcomeMiss    = "miss"
+)
+
+var (
+	providerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: ProviderRequestDuration,
+		Help: "Duration of provider calls by provider, method and outcome.",
+		// broker APIs answer in tens of milliseconds to seconds
+		Buckets: []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"provider", "method", "outcome"})
+
+	providerErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: ProviderErrorsTotal,
+		Help: "Failed provider calls by provider, method and error class.",
+	}, []string{"provider", "method", "class"})
+
+	cacheDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    CacheOperationDuration,
+		Help:    "Duration of cache operations by operation and outcome.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5},
+	}, []string{"operation", "outcome"})
+
+	cacheErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: CacheErrorsTotal,
+		Help: "Failed cache operations by operation and error class, misses are not errors.",
+	}, []string{"operation", "class"})
+
+	apiErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: APIErrorsTotal,
+		Help: "Failed API calls by method and error class.",
+	}, []string{"method", "class"})
+)
+
+// ErrorClass returns low cardinality class of error used as metric label.
+func ErrorClass(err error) string {
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, invest.ErrNotFound):
+		return "not_found"
+	case errors.Is(err, invest.ErrInvalidArgument):
+		return "invalid_argument"
+	}
+
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Canceled:
+			return "canceled"
+		case codes.DeadlineExceeded:
+			return "timeout"
+		case codes.NotFound:
+			return "not_found"
+		case codes.InvalidArgument:
+			return "invalid_argument"
+		case codes.ResourceExhausted:
+			return "rate_limited"
+		case codes.Unavailable:
+			return "unavailable"
+		case codes.Unauthenticated, codes.PermissionDenied:
+			return "unauthorized"
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return "timeout"
+		}
+		return "network"
+	}
+
+	return "internal"
+}
+
+// ObserveProvider records provider call which started at start.
+func ObserveProvider(provider, method string, start time.Time, err error) {
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeError
+		providerErrors.WithLabelValues(provider, method, ErrorClass(err)).Inc()
+	}
+	providerDuration.WithLabelValues(provider, method, outcome).Observe(time.Since(start).Seconds())
+}
+
+// ObserveAPIError counts failed API call of the method.
+func ObserveAPIError(method string, err error) {
+	apiErrors.WithLabelValues(method, ErrorClass(err)).Inc()
+}
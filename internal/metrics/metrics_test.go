@@ -0,0 +1,191 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+type testProvider struct {
+	invest.Provider
+	err error
+}
+
+func (p *testProvider) Accounts(context.Context, *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	return &pb.AccountsResponse{}, p.err
+}
+
+type testImporter struct {
+	testProvider
+}
+
+func (p *testImporter) Import(context.Context, *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error) {
+	return &pb.ImportStatementResponse{}, nil
+}
+
+type testCache struct {
+	values map[string]int
+	err    error
+}
+
+func (c *testCache) Get(_ context.Context, key string, ptrValue interface{}) error {
+	if c.err != nil {
+		return c.err
+	}
+	v, found := c.values[key]
+	if !found {
+		return invest.ErrCacheMiss
+	}
+	*ptrValue.(*int) = v
+	return nil
+}
+
+func (c *testCache) Set(_ context.Context, key string, ptrValue interface{}, _ time.Duration) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.values[key] = *ptrValue.(*int)
+	return nil
+}
+
+func TestInstrumentProvider(t *testing.T) {
+
+	ctx := context.Background()
+	failing := &testProvider{err: fmt.Errorf("load accounts: %w", context.DeadlineExceeded)}
+	p := InstrumentProvider("metrics-test", failing)
+	if _, err := p.Accounts(ctx, &pb.AccountsRequest{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Accounts() error = %v", err)
+	}
+	failing.err = nil
+	if _, err := p.Accounts(ctx, &pb.AccountsRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := testutil.ToFloat64(providerErrors.WithLabelValues("metrics-test", "Accounts", "timeout")); got != 1 {
+		t.Errorf("errors = %v, want 1", got)
+	}
+	if got := histogramCount(t, ProviderRequestDuration, "metrics-test", "Accounts", OutcomeSuccess); got != 1 {
+		t.Errorf("successful calls = %d, want 1", got)
+	}
+	if got := histogramCount(t, ProviderRequestDuration, "metrics-test", "Accounts", OutcomeError); got != 1 {
+		t.Errorf("failed calls = %d, want 1", got)
+	}
+
+	if _, ok := p.(invest.Importer); ok {
+		t.Error("provider which does not import statements is instrumented as importer")
+	}
+	if _, ok := invest.UnwrapProvider(p).(*testProvider); !ok {
+		t.Error("instrumented provider is not unwrapped")
+	}
+	if _, ok := InstrumentProvider("metrics-test", &testImporter{}).(invest.Importer); !ok {
+		t.Error("importer is instrumented as provider only")
+	}
+}
+
+func TestInstrumentCache(t *testing.T) {
+
+	ctx := context.Background()
+	raw := &testCache{values: map[string]int{}}
+	c := InstrumentCache(raw)
+	var v int
+	if err := c.Get(ctx, "key", &v); !errors.Is(err, invest.ErrCacheMiss) {
+		t.Errorf("Get() error = %v, want miss", err)
+	}
+	v = 1
+	if err := c.Set(ctx, "key", &v, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get(ctx, "key", &v); err != nil || v != 1 {
+		t.Errorf("Get() = %d, %v", v, err)
+	}
+	raw.err = &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	if err := c.Get(ctx, "key", &v); err == nil {
+		t.Error("Get() error is lost")
+	}
+
+	for outcome, want := range map[string]uint64{OutcomeHit: 1, OutcomeMiss: 1, OutcomeError: 1} {
+		if got := histogramCount(t, CacheOperationDuration, "get", outcome); got != want {
+			t.Errorf("get %s = %d, want %d", outcome, got, want)
+		}
+	}
+	if got := testutil.ToFloat64(cacheErrors.WithLabelValues("get", "network")); got != 1 {
+		t.Errorf("cache errors = %v, want 1", got)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{fmt.Errorf("wrapped: %w", invest.ErrNotFound), "not_found"},
+		{fmt.Errorf("%w: bad figi", invest.ErrInvalidArgument), "invalid_argument"},
+		{context.Canceled, "canceled"},
+		{status.Error(codes.ResourceExhausted, "too many"), "rate_limited"},
+		{status.Error(codes.Unavailable, "down"), "unavailable"},
+		{&net.DNSError{Err: "no such host", Name: "example.com"}, "network"},
+		{errors.New("boom"), "internal"},
+	}
+	for _, tt := range tests {
+		if got := ErrorClass(tt.err); got != tt.want {
+			t.Errorf("ErrorClass(%v) = %s, want %s", tt.err, got, tt.want)
+		}
+	}
+}
+
+// TestDashboard fails when committed dashboard is outdated, regenerate it with make dashboard.
+func TestDashboard(t *testing.T) {
+
+	generated, err := Dashboard()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d dashboard
+	if err := json.Unmarshal(generated, &d); err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Panels) != len(panels) {
+		t.Errorf("dashboard has %d panels, want %d", len(d.Panels), len(panels))
+	}
+
+	committed, err := ioutil.ReadFile("../../deploy/grafana/invest.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(committed, generated) {
+		t.Error("deploy/grafana/invest.json is outdated, run make dashboard")
+	}
+}
+
+// histogramCount returns number of observations of histogram with the labels.
+func histogramCount(t *testing.T, name string, labels ...string) uint64 {
+	t.Helper()
+
+	vec := providerDuration
+	if name == CacheOperationDuration {
+		vec = cacheDuration
+	}
+	observer, err := vec.GetMetricWithLabelValues(labels...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m dto.Metric
+	if err := observer.(prometheus.Metric).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
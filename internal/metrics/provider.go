@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"context"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// InstrumentProvider wraps provider to observe duration and errors of its calls.
+func InstrumentProvider(name string, provider invest.Provider) invest.Provider {
+	return invest.WrapProvider(provider, &instrumentedProvider{name: name, provider: provider})
+}
+
+type instrumentedProvider struct {
+	name     string
+	provider invest.Provider
+}
+
+// Unwrap returns the instrumented provider.
+func (p *instrumentedProvider) Unwrap() invest.Provider {
+	return p.provider
+}
+
+func (p *instrumentedProvider) Portfolio(ctx context.Context, req *pb.PortfolioRequest) (resp *pb.PortfolioResponse, err error) {
+	defer func(start time.Time) { ObserveProvider(p.name, "Portfolio", start, err) }(time.Now())
+	return p.provider.Portfolio(ctx, req)
+}
+
+func (p *instrumentedProvider) Accounts(ctx context.Context, req *pb.AccountsRequest) (resp *pb.AccountsResponse, err error) {
+	defer func(start time.Time) { ObserveProvider(p.name, "Accounts", start, err) }(time.Now())
+	return p.provider.Accounts(ctx, req)
+}
+
+func (p *instrumentedProvider) Operations(ctx context.Context, req *pb.OperationsRequest) (resp *pb.OperationsResponse, err error) {
+	defer func(start time.Time) { ObserveProvider(p.name, "Operations", start, err) }(time.Now())
+	return p.provider.Operations(ctx, req)
+}
+
+func (p *instrumentedProvider) Quote(ctx context.Context, req *pb.QuoteRequest) (resp *pb.QuoteResponse, err error) {
+	defer func(start time.Time) { ObserveProvider(p.name, "Quote", start, err) }(time.Now())
+	return p.provider.Quote(ctx, req)
+}
+
+func (p *instrumentedProvider) Import(ctx context.Context, req *pb.ImportStatementRequest) (resp *pb.ImportStatementResponse, err error) {
+	defer func(start time.Time) { ObserveProvider(p.name, "Import", start, err) }(time.Now())
+	return p.provider.(invest.Importer).Import(ctx, req)
+}
@@ -0,0 +1,266 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"strings"
+	"time"
+)
+
+// AlertRules returns rules of account, or all rules when account is empty.
+func (s *Storage) AlertRules(ctx context.Context, provider, accountID string) ([]invest.AlertRule, error) {
+
+	query := `SELECT id, type, name, provider, account_id, figi, threshold, enabled, created_at, active, peak, triggered_at
+		FROM alert_rules`
+	var args []interface{}
+	if accountID != "" {
+		query += ` WHERE provider = ? AND account_id = ?`
+		args = append(args, provider, accountID)
+	}
+	query += ` ORDER BY id`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting alert rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []invest.AlertRule
+	for rows.Next() {
+		var (
+			r           invest.AlertRule
+			alertType   int32
+			triggeredAt sql.NullTime
+		)
+		err := rows.Scan(&r.ID, &alertType, &r.Name, &r.Provider, &r.AccountID, &r.Figi, &r.Threshold, &r.Enabled,
+			&r.CreatedAt, &r.Active, &r.Peak, &triggeredAt)
+		if err != nil {
+			return nil, fmt.Errorf("problem while scanning alert rule: %w", err)
+		}
+		r.Type = pb.AlertType(alertType)
+		r.TriggeredAt = triggeredAt.Time
+		rules = append(rules, r)
+	}
+
+	return rules, rows.Err()
+}
+
+// SaveAlertRule creates rule or replaces its definition resetting evaluation state.
+func (s *Storage) SaveAlertRule(ctx context.Context, r *invest.AlertRule) error {
+
+	if r.ID == 0 {
+		const query = `INSERT INTO alert_rules (type, name, provider, account_id, figi, threshold, enabled, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+		result, err := s.db.ExecContext(ctx, query, int32(r.Type), r.Name, r.Provider, r.AccountID, r.Figi, r.Threshold,
+			r.Enabled, r.CreatedAt.UTC())
+		if err != nil {
+			return fmt.Errorf("problem while creating alert rule: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("problem while getting alert rule id: %w", err)
+		}
+		r.ID = id
+		return nil
+	}
+
+	const query = `UPDATE alert_rules SET type = ?, name = ?, provider = ?, account_id = ?, figi = ?, threshold = ?,
+		enabled = ?, active = FALSE, peak = 0 WHERE id = ?`
+
+	result, err := s.db.ExecContext(ctx, query, int32(r.Type), r.Name, r.Provider, r.AccountID, r.Figi, r.Threshold,
+		r.Enabled, r.ID)
+	if err != nil {
+		return fmt.Errorf("problem while updating alert rule %d: %w", r.ID, err)
+	}
+	if err := s.checkExists(ctx, result, "alert_rules", "alert rule", r.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkExists distinguishes update of missing row from update which changed nothing,
+// MySQL reports zero affected rows in both cases. Table is never user input.
+func (s *Storage) checkExists(ctx context.Context, result sql.Result, table, entity string, id int64) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("problem while getting affected rows of %s: %w", table, err)
+	}
+	if affected > 0 {
+		return nil
+	}
+	var exists bool
+	err = s.db.QueryRowContext(ctx, `SELECT TRUE FROM `+table+` WHERE id = ?`, id).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s %d: %w", entity, id, invest.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("problem while checking %s %d: %w", entity, id, err)
+	}
+	return nil
+}
+
+// DeleteAlertRule deletes rule among with its events in a single transaction.
+func (s *Storage) DeleteAlertRule(ctx context.Context, id int64) (err error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("problem while starting transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM alert_events WHERE rule_id = ?`, id); err != nil {
+		return fmt.Errorf("problem while deleting alert events of rule %d: %w", id, err)
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM alert_rules WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("problem while deleting alert rule %d: %w", id, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("problem while getting deleted alert rules: %w", err)
+	}
+	if affected == 0 {
+		err = fmt.Errorf("alert rule %d: %w", id, invest.ErrNotFound)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("problem while committing alert rule deletion: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAlertState saves evaluation state of rule.
+func (s *Storage) UpdateAlertState(ctx context.Context, r invest.AlertRule) error {
+
+	const query = `UPDATE alert_rules SET active = ?, peak = ?, triggered_at = ? WHERE id = ?`
+
+	var triggeredAt sql.NullTime
+	if !r.TriggeredAt.IsZero() {
+		triggeredAt = sql.NullTime{Time: r.TriggeredAt.UTC(), Valid: true}
+	}
+	if _, err := s.db.ExecContext(ctx, query, r.Active, r.Peak, triggeredAt, r.ID); err != nil {
+		return fmt.Errorf("problem while updating state of alert rule %d: %w", r.ID, err)
+	}
+
+	return nil
+}
+
+// SaveAlertEvent stores triggered alert and sets its id.
+func (s *Storage) SaveAlertEvent(ctx context.Context, e *invest.AlertEvent) error {
+
+	const query = `INSERT INTO alert_events
+		(rule_id, type, provider, account_id, figi, threshold, value, message, triggered_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := s.db.ExecContext(ctx, query, e.RuleID, int32(e.Type), e.Provider, e.AccountID, e.Figi, e.Threshold,
+		e.Value, e.Message, e.TriggeredAt.UTC())
+	if err != nil {
+		return fmt.Errorf("problem while saving alert event of rule %d: %w", e.RuleID, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("problem while getting alert event id: %w", err)
+	}
+	e.ID = id
+
+	return nil
+}
+
+// AlertEvents returns events matching filter, newest first.
+func (s *Storage) AlertEvents(ctx context.Context, filter invest.AlertEventFilter) ([]invest.AlertEvent, error) {
+
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if filter.RuleID != 0 {
+		conditions = append(conditions, `rule_id = ?`)
+		args = append(args, filter.RuleID)
+	}
+	if filter.AccountID != "" {
+		conditions = append(conditions, `provider = ? AND account_id = ?`)
+		args = append(args, filter.Provider, filter.AccountID)
+	}
+	if !filter.From.IsZero() {
+		conditions = append(conditions, `triggered_at >= ?`)
+		args = append(args, filter.From.UTC())
+	}
+
+	query := `SELECT id, rule_id, type, provider, account_id, figi, threshold, value, message, triggered_at FROM alert_events`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, ` AND `)
+	}
+	query += ` ORDER BY triggered_at DESC, id DESC`
+	if filter.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting alert events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []invest.AlertEvent
+	for rows.Next() {
+		var (
+			e         invest.AlertEvent
+			alertType int32
+		)
+		err := rows.Scan(&e.ID, &e.RuleID, &alertType, &e.Provider, &e.AccountID, &e.Figi, &e.Threshold, &e.Value,
+			&e.Message, &e.TriggeredAt)
+		if err != nil {
+			return nil, fmt.Errorf("problem while scanning alert event: %w", err)
+		}
+		e.Type = pb.AlertType(alertType)
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// SavePortfolioSnapshot creates or replaces snapshot of account at the date.
+func (s *Storage) SavePortfolioSnapshot(ctx context.Context, snapshot invest.PortfolioSnapshot) error {
+
+	const query = `INSERT INTO portfolio_snapshots (provider, account_id, date, value) VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE value = VALUES(value)`
+
+	_, err := s.db.ExecContext(ctx, query, snapshot.Provider, snapshot.AccountID, snapshot.Date.Format("2006-01-02"),
+		snapshot.Value)
+	if err != nil {
+		return fmt.Errorf("problem while saving portfolio snapshot of account %s: %w", snapshot.AccountID, err)
+	}
+
+	return nil
+}
+
+// PortfolioSnapshot returns the latest snapshot of account taken before the date.
+func (s *Storage) PortfolioSnapshot(ctx context.Context, provider, accountID string, before time.Time) (invest.PortfolioSnapshot, error) {
+
+	const query = `SELECT date, value FROM portfolio_snapshots WHERE provider = ? AND account_id = ? AND date < ?
+		ORDER BY date DESC LIMIT 1`
+
+	snapshot := invest.PortfolioSnapshot{Provider: provider, AccountID: accountID}
+	err := s.db.QueryRowContext(ctx, query, provider, accountID, before.Format("2006-01-02")).Scan(&snapshot.Date, &snapshot.Value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return invest.PortfolioSnapshot{}, fmt.Errorf("portfolio snapshot of account %s: %w", accountID, invest.ErrNotFound)
+	}
+	if err != nil {
+		return invest.PortfolioSnapshot{}, fmt.Errorf("problem while selecting portfolio snapshot of account %s: %w", accountID, err)
+	}
+
+	return snapshot, nil
+}
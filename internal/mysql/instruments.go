@@ -0,0 +1,55 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"goinvest/internal/invest"
+	"strings"
+)
+
+// Instruments returns metadata of known instruments by figi.
+func (s *Storage) Instruments(ctx context.Context, figis []string) (map[string]invest.Instrument, error) {
+
+	instruments := make(map[string]invest.Instrument, len(figis))
+	if len(figis) == 0 {
+		return instruments, nil
+	}
+
+	args := make([]interface{}, 0, len(figis))
+	for _, figi := range figis {
+		args = append(args, figi)
+	}
+
+	query := `SELECT figi, ticker, isin, name, sector, country, issuer FROM instruments WHERE figi IN (?` +
+		strings.Repeat(",?", len(figis)-1) + `)`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting instruments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var i invest.Instrument
+		if err := rows.Scan(&i.Figi, &i.Ticker, &i.Isin, &i.Name, &i.Sector, &i.Country, &i.Issuer); err != nil {
+			return nil, fmt.Errorf("problem while scanning instrument: %w", err)
+		}
+		instruments[i.Figi] = i
+	}
+
+	return instruments, rows.Err()
+}
+
+// SaveInstrument creates or replaces instrument metadata.
+func (s *Storage) SaveInstrument(ctx context.Context, i invest.Instrument) error {
+
+	const query = `INSERT INTO instruments (figi, ticker, isin, name, sector, country, issuer) VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE ticker = VALUES(ticker), isin = VALUES(isin), name = VALUES(name),
+		sector = VALUES(sector), country = VALUES(country), issuer = VALUES(issuer)`
+
+	if _, err := s.db.ExecContext(ctx, query, i.Figi, i.Ticker, i.Isin, i.Name, i.Sector, i.Country, i.Issuer); err != nil {
+		return fmt.Errorf("problem while saving instrument %s: %w", i.Figi, err)
+	}
+
+	return nil
+}
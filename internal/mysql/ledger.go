@@ -0,0 +1,232 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// LedgerAccounts returns accounts of provider.
+func (s *Storage) LedgerAccounts(ctx context.Context, provider invest.ProviderID) ([]invest.LedgerAccount, error) {
+
+	const query = `SELECT account_id, account_type, name FROM ledger_accounts WHERE provider_id = ? ORDER BY account_id`
+
+	rows, err := s.db.QueryContext(ctx, query, provider.Uint32())
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting ledger accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []invest.LedgerAccount
+	for rows.Next() {
+		a := invest.LedgerAccount{Provider: provider}
+		var accountType int32
+		if err := rows.Scan(&a.AccountID, &accountType, &a.Name); err != nil {
+			return nil, fmt.Errorf("problem while scanning ledger account: %w", err)
+		}
+		a.AccountType = pb.AccountType(accountType)
+		accounts = append(accounts, a)
+	}
+
+	return accounts, rows.Err()
+}
+
+// SaveLedgerAccount creates or replaces account.
+func (s *Storage) SaveLedgerAccount(ctx context.Context, a invest.LedgerAccount) error {
+
+	const query = `INSERT INTO ledger_accounts (provider_id, account_id, account_type, name) VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE account_type = VALUES(account_type), name = VALUES(name)`
+
+	if _, err := s.db.ExecContext(ctx, query, a.Provider.Uint32(), a.AccountID, int32(a.AccountType), a.Name); err != nil {
+		return fmt.Errorf("problem while saving ledger account %s: %w", a.AccountID, err)
+	}
+
+	return nil
+}
+
+// DeleteLedgerAccount deletes account among with its positions and operations in a single transaction.
+func (s *Storage) DeleteLedgerAccount(ctx context.Context, provider invest.ProviderID, accountID string) (err error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("problem while starting transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, table := range []string{"ledger_operations", "ledger_positions", "ledger_accounts"} {
+		query := `DELETE FROM ` + table + ` WHERE provider_id = ? AND account_id = ?`
+		if _, err = tx.ExecContext(ctx, query, provider.Uint32(), accountID); err != nil {
+			return fmt.Errorf("problem while deleting from %s: %w", table, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("problem while committing ledger account deletion: %w", err)
+	}
+
+	return nil
+}
+
+// LedgerPositions returns positions of account.
+func (s *Storage) LedgerPositions(ctx context.Context, provider invest.ProviderID, accountID string) ([]invest.LedgerPosition, error) {
+
+	const query = `SELECT figi, ticker, isin, name, instrument_type, balance, average_price, price, currency
+		FROM ledger_positions WHERE provider_id = ? AND account_id = ? ORDER BY position_key`
+
+	rows, err := s.db.QueryContext(ctx, query, provider.Uint32(), accountID)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting ledger positions: %w", err)
+	}
+	defer rows.Close()
+
+	var positions []invest.LedgerPosition
+	for rows.Next() {
+		var p invest.LedgerPosition
+		err := rows.Scan(&p.Figi, &p.Ticker, &p.Isin, &p.Name, &p.InstrumentType, &p.Balance, &p.AveragePrice, &p.Price, &p.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("problem while scanning ledger position: %w", err)
+		}
+		positions = append(positions, p)
+	}
+
+	return positions, rows.Err()
+}
+
+// ReplaceLedgerPositions replaces all positions of account in a single transaction.
+func (s *Storage) ReplaceLedgerPositions(ctx context.Context, provider invest.ProviderID, accountID string, positions []invest.LedgerPosition) (err error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("problem while starting transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM ledger_positions WHERE provider_id = ? AND account_id = ?`, provider.Uint32(), accountID); err != nil {
+		return fmt.Errorf("problem while deleting ledger positions: %w", err)
+	}
+
+	const query = `INSERT INTO ledger_positions
+		(provider_id, account_id, position_key, figi, ticker, isin, name, instrument_type, balance, average_price, price, currency)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	for _, p := range positions {
+		_, err = tx.ExecContext(ctx, query, provider.Uint32(), accountID, p.Key(), p.Figi, p.Ticker, p.Isin, p.Name,
+			p.InstrumentType, p.Balance, p.AveragePrice, p.Price, p.Currency)
+		if err != nil {
+			return fmt.Errorf("problem while inserting ledger position %s: %w", p.Key(), err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("problem while committing ledger positions: %w", err)
+	}
+
+	return nil
+}
+
+// SaveLedgerPosition creates or replaces a single position of account by its key.
+func (s *Storage) SaveLedgerPosition(ctx context.Context, provider invest.ProviderID, accountID string, p invest.LedgerPosition) error {
+
+	const query = `INSERT INTO ledger_positions
+		(provider_id, account_id, position_key, figi, ticker, isin, name, instrument_type, balance, average_price, price, currency)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE figi = VALUES(figi), ticker = VALUES(ticker), isin = VALUES(isin), name = VALUES(name),
+		instrument_type = VALUES(instrument_type), balance = VALUES(balance), average_price = VALUES(average_price),
+		price = VALUES(price), currency = VALUES(currency)`
+
+	_, err := s.db.ExecContext(ctx, query, provider.Uint32(), accountID, p.Key(), p.Figi, p.Ticker, p.Isin, p.Name,
+		p.InstrumentType, p.Balance, p.AveragePrice, p.Price, p.Currency)
+	if err != nil {
+		return fmt.Errorf("problem while saving ledger position %s: %w", p.Key(), err)
+	}
+
+	return nil
+}
+
+// DeleteLedgerPosition deletes position of account by its key.
+func (s *Storage) DeleteLedgerPosition(ctx context.Context, provider invest.ProviderID, accountID string, key string) error {
+
+	const query = `DELETE FROM ledger_positions WHERE provider_id = ? AND account_id = ? AND position_key = ?`
+
+	if _, err := s.db.ExecContext(ctx, query, provider.Uint32(), accountID, key); err != nil {
+		return fmt.Errorf("problem while deleting ledger position %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// LedgerOperations returns operations of account within [from, to) period ordered by date.
+func (s *Storage) LedgerOperations(ctx context.Context, provider invest.ProviderID, accountID string, from, to time.Time) ([]invest.LedgerOperation, error) {
+
+	const query = `SELECT operation_id, operation_type, figi, ticker, isin, instrument_type, date, quantity, price, payment,
+		currency, commission FROM ledger_operations WHERE provider_id = ? AND account_id = ? AND date >= ? AND date < ? ORDER BY date, operation_id`
+
+	rows, err := s.db.QueryContext(ctx, query, provider.Uint32(), accountID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting ledger operations: %w", err)
+	}
+	defer rows.Close()
+
+	var operations []invest.LedgerOperation
+	for rows.Next() {
+		var (
+			o             invest.LedgerOperation
+			operationType int32
+		)
+		err := rows.Scan(&o.ID, &operationType, &o.Figi, &o.Ticker, &o.Isin, &o.InstrumentType, &o.Date, &o.Quantity,
+			&o.Price, &o.Payment, &o.Currency, &o.Commission)
+		if err != nil {
+			return nil, fmt.Errorf("problem while scanning ledger operation: %w", err)
+		}
+		o.OperationType = pb.OperationType(operationType)
+		operations = append(operations, o)
+	}
+
+	return operations, rows.Err()
+}
+
+// SaveLedgerOperations creates or replaces operations of account in a single transaction.
+func (s *Storage) SaveLedgerOperations(ctx context.Context, provider invest.ProviderID, accountID string, operations []invest.LedgerOperation) (err error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("problem while starting transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `INSERT INTO ledger_operations
+		(provider_id, account_id, operation_id, operation_type, figi, ticker, isin, instrument_type, date, quantity, price,
+		payment, currency, commission)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE operation_type = VALUES(operation_type), figi = VALUES(figi), ticker = VALUES(ticker),
+		isin = VALUES(isin), instrument_type = VALUES(instrument_type), date = VALUES(date), quantity = VALUES(quantity), price = VALUES(price),
+		payment = VALUES(payment), currency = VALUES(currency), commission = VALUES(commission)`
+
+	for _, o := range operations {
+		_, err = tx.ExecContext(ctx, query, provider.Uint32(), accountID, o.ID, int32(o.OperationType), o.Figi, o.Ticker,
+			o.Isin, o.InstrumentType, o.Date, o.Quantity, o.Price, o.Payment, o.Currency, o.Commission)
+		if err != nil {
+			return fmt.Errorf("problem while saving ledger operation %s: %w", o.ID, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("problem while committing ledger operations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/pressly/goose/v3"
+	"go.uber.org/zap"
+	"goinvest/migrations"
+	"os"
+)
+
+// DefaultMigrationsTable stores versions of applied migrations.
+const DefaultMigrationsTable = "goose_db_version"
+
+// MigrationsConfig configures schema migrations applied on start.
+type MigrationsConfig struct {
+	Enabled   bool   `yaml:"enabled"`   // applies pending migrations before the server starts
+	Table     string `yaml:"table"`     // DefaultMigrationsTable when empty
+	Directory string `yaml:"directory"` // migrations embedded into binary are applied when empty
+	Verbose   bool   `yaml:"verbose"`
+}
+
+// Migrate applies pending migrations of config to the database, it does nothing when migrations are disabled.
+func Migrate(db *sql.DB, conf MigrationsConfig, logger *zap.Logger) error {
+
+	if db == nil {
+		return errors.New("database provided to migrations is nil")
+	}
+	if logger == nil {
+		return errors.New("logger provided to migrations is nil")
+	}
+	if !conf.Enabled {
+		return nil
+	}
+
+	table := conf.Table
+	if table == "" {
+		table = DefaultMigrationsTable
+	}
+	goose.SetBaseFS(migrations.FS)
+	if conf.Directory != "" {
+		goose.SetBaseFS(os.DirFS(conf.Directory))
+	}
+	goose.SetLogger(zap.NewStdLog(logger.With(zap.String("service", "goose"))))
+	if err := goose.SetDialect("mysql"); err != nil {
+		return fmt.Errorf("goose problem while setting dialect: %w", err)
+	}
+	goose.SetTableName(table)
+	goose.SetVerbose(conf.Verbose)
+	if err := goose.Up(db, "."); err != nil {
+		return fmt.Errorf("goose migration failed: %w", err)
+	}
+	return nil
+}
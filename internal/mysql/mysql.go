@@ -5,29 +5,32 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"go.uber.org/zap"
+	"goinvest/internal/tracing"
 )
 
 // DBConfig contains information sufficient for database connection.
 type DBConfig struct {
 	Net        string        `yaml:"net"`
-	Host       string        `yaml:"host"`
-	Port       int           `yaml:"port"`
-	DBName     string        `yaml:"dbName"`
-	User       string        `yaml:"user"`
-	Password   string        `yaml:"password"`
-	TimeZone   string        `yaml:"timeZone"`
+	Host       string        `yaml:"host" validate:"required"`
+	Port       int           `yaml:"port" validate:"gte=0,lte=65535"` // joined with host when set
+	DBName     string        `yaml:"dbName" validate:"required"`
+	User       string        `yaml:"user" validate:"required"`
+	Password   string        `yaml:"password" secret:"true"`
+	TimeZone   string        `yaml:"timeZone" validate:"required"`
 	PoolConfig PoolConfig    `yaml:"poolConfig"`
-	Timeout    time.Duration `yaml:"timeout"` // timeout for trying to connect to the database
+	Timeout    time.Duration `yaml:"timeout" validate:"gte=0"` // timeout for trying to connect to the database
 }
 
 type PoolConfig struct {
-	MaxOpenConnections int           `yaml:"maxOpenConnections"`
-	MaxIdleConnections int           `yaml:"maxIdleConnections"`
-	MaxLifetime        time.Duration `yaml:"maxLifetime"`
+	MaxOpenConnections int           `yaml:"maxOpenConnections" validate:"gte=0"`
+	MaxIdleConnections int           `yaml:"maxIdleConnections" validate:"gte=0"`
+	MaxLifetime        time.Duration `yaml:"maxLifetime" validate:"gte=0"`
 }
 
 // ConnectLoop takes config and specified database credentials as input, returning *sql.DB handle for interactions
@@ -50,6 +53,9 @@ func ConnectLoop(ctx context.Context, cfg DBConfig, logger *zap.Logger) (db *sql
 	conf := mysql.NewConfig()
 	conf.Net = cfg.Net
 	conf.Addr = cfg.Host
+	if cfg.Port != 0 {
+		conf.Addr = net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	}
 	conf.User = cfg.User
 	conf.Passwd = cfg.Password
 	conf.DBName = cfg.DBName
@@ -57,10 +63,7 @@ func ConnectLoop(ctx context.Context, cfg DBConfig, logger *zap.Logger) (db *sql
 	conf.Timeout = time.Second * 2
 	conf.Loc = loc
 
-	dsn := conf.FormatDSN()
-
-	const driverName = "mysql"
-	db, err = createDBPool(ctx, driverName, dsn)
+	db, err = createDBPool(ctx, conf)
 	if nil == err {
 		configureDBPool(db, cfg.PoolConfig)
 		return db, db.Close, nil
@@ -85,7 +88,7 @@ func ConnectLoop(ctx context.Context, cfg DBConfig, logger *zap.Logger) (db *sql
 			return nil, nil, fmt.Errorf("db connection failed after %s timeout", cfg.Timeout)
 
 		case <-ticker.C:
-			db, err := createDBPool(ctx, driverName, dsn)
+			db, err := createDBPool(ctx, conf)
 			if nil == err {
 				configureDBPool(db, cfg.PoolConfig)
 				return db, db.Close, nil
@@ -99,12 +102,13 @@ func ConnectLoop(ctx context.Context, cfg DBConfig, logger *zap.Logger) (db *sql
 
 }
 
-func createDBPool(ctx context.Context, driverName string, dsn string) (*sql.DB, error) {
+func createDBPool(ctx context.Context, conf *mysql.Config) (*sql.DB, error) {
 
-	db, err := sql.Open(driverName, dsn)
+	connector, err := mysql.NewConnector(conf)
 	if err != nil {
 		return nil, fmt.Errorf("problem opens a database specified by its database driver: %w", err)
 	}
+	db := sql.OpenDB(tracing.TraceConnector(connector, "mysql"))
 
 	if err := db.PingContext(ctx); err != nil {
 		return nil, fmt.Errorf("problem while trying to ping database: %w", err)
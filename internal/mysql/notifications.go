@@ -0,0 +1,125 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"goinvest/internal/invest"
+	"strings"
+)
+
+// NotificationChannels returns channels of user, or channels of all users when user is empty.
+func (s *Storage) NotificationChannels(ctx context.Context, user string) ([]invest.NotificationChannel, error) {
+
+	query := `SELECT id, username, type, target, secret, kinds, enabled, created_at FROM notification_channels`
+	var args []interface{}
+	if user != "" {
+		query += ` WHERE username = ?`
+		args = append(args, user)
+	}
+	query += ` ORDER BY id`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting notification channels: %w", err)
+	}
+	defer rows.Close()
+
+	var channels []invest.NotificationChannel
+	for rows.Next() {
+		var (
+			c     invest.NotificationChannel
+			kinds string
+		)
+		if err := rows.Scan(&c.ID, &c.User, &c.Type, &c.Target, &c.Secret, &kinds, &c.Enabled, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("problem while scanning notification channel: %w", err)
+		}
+		if kinds != "" {
+			c.Kinds = strings.Split(kinds, ",")
+		}
+		channels = append(channels, c)
+	}
+
+	return channels, rows.Err()
+}
+
+// SaveNotificationChannel creates channel or replaces existing one.
+func (s *Storage) SaveNotificationChannel(ctx context.Context, c *invest.NotificationChannel) error {
+
+	kinds := strings.Join(c.Kinds, ",")
+	if c.ID == 0 {
+		const query = `INSERT INTO notification_channels (username, type, target, secret, kinds, enabled, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+		result, err := s.db.ExecContext(ctx, query, c.User, c.Type, c.Target, c.Secret, kinds, c.Enabled, c.CreatedAt.UTC())
+		if err != nil {
+			return fmt.Errorf("problem while creating notification channel: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("problem while getting notification channel id: %w", err)
+		}
+		c.ID = id
+		return nil
+	}
+
+	const query = `UPDATE notification_channels SET username = ?, type = ?, target = ?, secret = ?, kinds = ?, enabled = ?
+		WHERE id = ?`
+
+	result, err := s.db.ExecContext(ctx, query, c.User, c.Type, c.Target, c.Secret, kinds, c.Enabled, c.ID)
+	if err != nil {
+		return fmt.Errorf("problem while updating notification channel %d: %w", c.ID, err)
+	}
+	if err := s.checkExists(ctx, result, "notification_channels", "notification channel", c.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNotificationChannel deletes channel, undelivered notifications are kept for investigation.
+func (s *Storage) DeleteNotificationChannel(ctx context.Context, id int64) error {
+
+	result, err := s.db.ExecContext(ctx, `DELETE FROM notification_channels WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("problem while deleting notification channel %d: %w", id, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("problem while getting deleted notification channels: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("notification channel %d: %w", id, invest.ErrNotFound)
+	}
+
+	return nil
+}
+
+// SaveDeadLetter stores undelivered notification and sets its id.
+func (s *Storage) SaveDeadLetter(ctx context.Context, l *invest.DeadLetter) error {
+
+	const query = `INSERT INTO notification_dead_letters
+		(channel_id, channel_type, target, kind, subject, text, error, attempts, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := s.db.ExecContext(ctx, query, l.ChannelID, l.ChannelType, l.Target, l.Kind, l.Subject, l.Text,
+		truncate(l.Error, 1024), l.Attempts, l.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("problem while saving dead letter of channel %d: %w", l.ChannelID, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("problem while getting dead letter id: %w", err)
+	}
+	l.ID = id
+
+	return nil
+}
+
+// truncate cuts string to n runes to fit column.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
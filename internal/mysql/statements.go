@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"goinvest/internal/invest"
+)
+
+// SaveStatement stores statement and sets its id.
+func (s *Storage) SaveStatement(ctx context.Context, st *invest.Statement) error {
+
+	const query = `INSERT INTO statements
+		(provider, account_id, period, period_from, period_to, format, filename, content_type, content, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := s.db.ExecContext(ctx, query, st.Provider, st.AccountID, st.Period, st.From.UTC(), st.To.UTC(),
+		st.Format, st.Filename, st.ContentType, st.Content, st.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("problem while saving statement of account %s: %w", st.AccountID, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("problem while getting statement id: %w", err)
+	}
+	st.ID = id
+
+	return nil
+}
+
+// Statements returns statements of account without content, newest periods first.
+func (s *Storage) Statements(ctx context.Context, provider, accountID string) ([]invest.Statement, error) {
+
+	const query = `SELECT id, provider, account_id, period, period_from, period_to, format, filename, content_type, created_at
+		FROM statements WHERE provider = ? AND account_id = ? ORDER BY period_from DESC, id DESC`
+
+	rows, err := s.db.QueryContext(ctx, query, provider, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting statements: %w", err)
+	}
+	defer rows.Close()
+
+	var statements []invest.Statement
+	for rows.Next() {
+		var st invest.Statement
+		err := rows.Scan(&st.ID, &st.Provider, &st.AccountID, &st.Period, &st.From, &st.To, &st.Format, &st.Filename,
+			&st.ContentType, &st.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("problem while scanning statement: %w", err)
+		}
+		statements = append(statements, st)
+	}
+
+	return statements, rows.Err()
+}
+
+// Statement returns statement with content by id.
+func (s *Storage) Statement(ctx context.Context, id int64) (invest.Statement, error) {
+
+	const query = `SELECT id, provider, account_id, period, period_from, period_to, format, filename, content_type, content,
+		created_at FROM statements WHERE id = ?`
+
+	var st invest.Statement
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&st.ID, &st.Provider, &st.AccountID, &st.Period, &st.From, &st.To,
+		&st.Format, &st.Filename, &st.ContentType, &st.Content, &st.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return invest.Statement{}, fmt.Errorf("statement %d: %w", id, invest.ErrNotFound)
+	}
+	if err != nil {
+		return invest.Statement{}, fmt.Errorf("problem while selecting statement %d: %w", id, err)
+	}
+
+	return st, nil
+}
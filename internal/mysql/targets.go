@@ -0,0 +1,63 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"goinvest/internal/invest"
+)
+
+// TargetWeights returns target weights of provider account.
+func (s *Storage) TargetWeights(ctx context.Context, provider, accountID string) ([]invest.TargetWeight, error) {
+
+	const query = `SELECT figi, weight FROM target_weights WHERE provider = ? AND account_id = ? ORDER BY figi`
+
+	rows, err := s.db.QueryContext(ctx, query, provider, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("problem while selecting target weights: %w", err)
+	}
+	defer rows.Close()
+
+	var weights []invest.TargetWeight
+	for rows.Next() {
+		var w invest.TargetWeight
+		if err := rows.Scan(&w.Figi, &w.Weight); err != nil {
+			return nil, fmt.Errorf("problem while scanning target weight: %w", err)
+		}
+		weights = append(weights, w)
+	}
+
+	return weights, rows.Err()
+}
+
+// SaveTargetWeights replaces target weights of provider account in a single transaction.
+func (s *Storage) SaveTargetWeights(ctx context.Context, provider, accountID string, weights []invest.TargetWeight) (err error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("problem while starting transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM target_weights WHERE provider = ? AND account_id = ?`,
+		provider, accountID); err != nil {
+		return fmt.Errorf("problem while deleting target weights: %w", err)
+	}
+
+	for _, w := range weights {
+		_, err = tx.ExecContext(ctx, `INSERT INTO target_weights (provider, account_id, figi, weight) VALUES (?, ?, ?, ?)`,
+			provider, accountID, w.Figi, w.Weight)
+		if err != nil {
+			return fmt.Errorf("problem while inserting target weight: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("problem while committing target weights: %w", err)
+	}
+
+	return nil
+}
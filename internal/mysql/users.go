@@ -0,0 +1,85 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"goinvest/internal/invest"
+)
+
+const userColumns = `id, name, telegram_id, link_code, link_expires_at, created_at`
+
+// User returns user by name.
+func (s *Storage) User(ctx context.Context, name string) (invest.User, error) {
+	return s.user(ctx, `SELECT `+userColumns+` FROM users WHERE name = ?`, name)
+}
+
+// UserByTelegramID returns user linked to Telegram account.
+func (s *Storage) UserByTelegramID(ctx context.Context, telegramID int64) (invest.User, error) {
+	return s.user(ctx, `SELECT `+userColumns+` FROM users WHERE telegram_id = ?`, telegramID)
+}
+
+// UserByLinkCode returns user with the link code.
+func (s *Storage) UserByLinkCode(ctx context.Context, code string) (invest.User, error) {
+	return s.user(ctx, `SELECT `+userColumns+` FROM users WHERE link_code = ?`, code)
+}
+
+func (s *Storage) user(ctx context.Context, query string, arg interface{}) (invest.User, error) {
+
+	var (
+		u             invest.User
+		telegramID    sql.NullInt64
+		linkCode      sql.NullString
+		linkExpiresAt sql.NullTime
+	)
+	err := s.db.QueryRowContext(ctx, query, arg).Scan(&u.ID, &u.Name, &telegramID, &linkCode, &linkExpiresAt, &u.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return invest.User{}, fmt.Errorf("user %v: %w", arg, invest.ErrNotFound)
+	}
+	if err != nil {
+		return invest.User{}, fmt.Errorf("problem while selecting user %v: %w", arg, err)
+	}
+	u.TelegramID = telegramID.Int64
+	u.LinkCode = linkCode.String
+	u.LinkExpiresAt = linkExpiresAt.Time
+
+	return u, nil
+}
+
+// SaveUser creates or updates user, empty telegram id and link code are stored as NULL to keep them unique.
+func (s *Storage) SaveUser(ctx context.Context, u *invest.User) error {
+
+	var (
+		telegramID    = sql.NullInt64{Int64: u.TelegramID, Valid: u.TelegramID != 0}
+		linkCode      = sql.NullString{String: u.LinkCode, Valid: u.LinkCode != ""}
+		linkExpiresAt = sql.NullTime{Time: u.LinkExpiresAt.UTC(), Valid: !u.LinkExpiresAt.IsZero()}
+	)
+
+	if u.ID == 0 {
+		const query = `INSERT INTO users (name, telegram_id, link_code, link_expires_at, created_at) VALUES (?, ?, ?, ?, ?)`
+
+		result, err := s.db.ExecContext(ctx, query, u.Name, telegramID, linkCode, linkExpiresAt, u.CreatedAt.UTC())
+		if err != nil {
+			return fmt.Errorf("problem while creating user %s: %w", u.Name, err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("problem while getting user id: %w", err)
+		}
+		u.ID = id
+		return nil
+	}
+
+	const query = `UPDATE users SET name = ?, telegram_id = ?, link_code = ?, link_expires_at = ? WHERE id = ?`
+
+	result, err := s.db.ExecContext(ctx, query, u.Name, telegramID, linkCode, linkExpiresAt, u.ID)
+	if err != nil {
+		return fmt.Errorf("problem while updating user %s: %w", u.Name, err)
+	}
+	if err := s.checkExists(ctx, result, "users", "user", u.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
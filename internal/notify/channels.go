@@ -0,0 +1,140 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"net/mail"
+	"net/url"
+	"time"
+)
+
+var kinds = map[string]bool{
+	invest.NotificationAlert:     true,
+	invest.NotificationStatement: true,
+}
+
+// Channels returns notification channels of the requested user, or of all users when user is not set.
+func Channels(ctx context.Context, storage invest.NotificationStorage, req *pb.NotificationChannelsRequest) (*pb.NotificationChannelsResponse, error) {
+
+	channels, err := storage.NotificationChannels(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.NotificationChannelsResponse{Channels: make([]*pb.NotificationChannel, 0, len(channels))}
+	for _, channel := range channels {
+		resp.Channels = append(resp.Channels, toPbChannel(channel))
+	}
+	return resp, nil
+}
+
+// SaveChannel validates and stores notification channel, channel without id is created.
+func SaveChannel(ctx context.Context, storage invest.NotificationStorage, req *pb.SaveNotificationChannelRequest) (*pb.SaveNotificationChannelResponse, error) {
+
+	if req.Channel == nil {
+		return nil, fmt.Errorf("%w: channel is required", invest.ErrInvalidArgument)
+	}
+	if err := validate(req.Channel); err != nil {
+		return nil, err
+	}
+
+	channel := invest.NotificationChannel{
+		ID:        req.Channel.Id,
+		User:      req.Channel.User,
+		Type:      req.Channel.Type,
+		Target:    req.Channel.Target,
+		Secret:    req.Channel.Secret,
+		Kinds:     req.Channel.Kinds,
+		Enabled:   req.Channel.Enabled,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	// secret is never returned, so update without it keeps the stored one
+	if channel.ID != 0 {
+		stored, err := findChannel(ctx, storage, channel.ID)
+		if err != nil {
+			return nil, err
+		}
+		channel.CreatedAt = stored.CreatedAt
+		if channel.Secret == "" {
+			channel.Secret = stored.Secret
+		}
+	}
+	if err := storage.SaveNotificationChannel(ctx, &channel); err != nil {
+		return nil, err
+	}
+
+	return &pb.SaveNotificationChannelResponse{Channel: toPbChannel(channel)}, nil
+}
+
+// DeleteChannel deletes notification channel.
+func DeleteChannel(ctx context.Context, storage invest.NotificationStorage, req *pb.DeleteNotificationChannelRequest) (*pb.DeleteNotificationChannelResponse, error) {
+
+	if req.Id <= 0 {
+		return nil, fmt.Errorf("%w: channel id is required", invest.ErrInvalidArgument)
+	}
+	if err := storage.DeleteNotificationChannel(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteNotificationChannelResponse{}, nil
+}
+
+func findChannel(ctx context.Context, storage invest.NotificationStorage, id int64) (invest.NotificationChannel, error) {
+	channels, err := storage.NotificationChannels(ctx, "")
+	if err != nil {
+		return invest.NotificationChannel{}, err
+	}
+	for _, channel := range channels {
+		if channel.ID == id {
+			return channel, nil
+		}
+	}
+	return invest.NotificationChannel{}, fmt.Errorf("notification channel %d: %w", id, invest.ErrNotFound)
+}
+
+func validate(channel *pb.NotificationChannel) error {
+
+	if channel.User == "" {
+		return fmt.Errorf("%w: user is required", invest.ErrInvalidArgument)
+	}
+	if channel.Target == "" {
+		return fmt.Errorf("%w: target is required", invest.ErrInvalidArgument)
+	}
+	for _, kind := range channel.Kinds {
+		if !kinds[kind] {
+			return fmt.Errorf("%w: unknown notification kind %q", invest.ErrInvalidArgument, kind)
+		}
+	}
+
+	switch channel.Type {
+	case ChannelWebhook:
+		u, err := url.Parse(channel.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: webhook target must be http or https url", invest.ErrInvalidArgument)
+		}
+	case ChannelEmail:
+		if _, err := mail.ParseAddress(channel.Target); err != nil {
+			return fmt.Errorf("%w: email target: %s", invest.ErrInvalidArgument, err)
+		}
+	case ChannelTelegram:
+	default:
+		return fmt.Errorf("%w: unknown channel type %q, supported types are webhook, email and telegram",
+			invest.ErrInvalidArgument, channel.Type)
+	}
+	return nil
+}
+
+// toPbChannel converts channel, secret is not returned.
+func toPbChannel(channel invest.NotificationChannel) *pb.NotificationChannel {
+	return &pb.NotificationChannel{
+		Id:        channel.ID,
+		User:      channel.User,
+		Type:      channel.Type,
+		Target:    channel.Target,
+		Kinds:     channel.Kinds,
+		Enabled:   channel.Enabled,
+		CreatedAt: channel.CreatedAt.Format(time.RFC3339),
+	}
+}
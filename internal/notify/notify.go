@@ -0,0 +1,211 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"goinvest/internal/invest"
+	"sync"
+	"time"
+)
+
+// Channel types.
+const (
+	ChannelWebhook  = "webhook"
+	ChannelEmail    = "email"
+	ChannelTelegram = "telegram"
+)
+
+const (
+	defaultAttempts = 3
+	defaultBackoff  = time.Second
+	defaultTimeout  = 10 * time.Second
+	// deadLetterTimeout bounds saving of undelivered notification when context is already done.
+	deadLetterTimeout = 5 * time.Second
+)
+
+// Config configures delivery of notifications.
+type Config struct {
+	Enabled bool `yaml:"enabled"`
+	// Attempts is how many times delivery to a channel is tried, three by default.
+	Attempts int `yaml:"attempts" validate:"gte=0"`
+	// Backoff is a delay before the second attempt, it doubles for every next one, a second by default.
+	Backoff time.Duration `yaml:"backoff" validate:"gte=0"`
+	// Timeout of a single http request or email, ten seconds by default.
+	Timeout time.Duration `yaml:"timeout" validate:"gte=0"`
+	SMTP    SMTPConfig    `yaml:"smtp"`
+}
+
+// Sender delivers notification over channels of a single type.
+type Sender interface {
+	Send(ctx context.Context, channel invest.NotificationChannel, notification invest.Notification) error
+}
+
+// permanentError is a failure which repeated attempts would not fix, e.g. rejected recipient.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks error of sender as not worth retrying.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// IsPermanent reports whether error of sender is marked as not worth retrying.
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+// Notifier delivers notifications to every enabled channel subscribed to their kind, whichever user it belongs to.
+// Delivery is retried with exponential backoff, notifications which were not delivered
+// are stored as dead letters.
+type Notifier struct {
+	conf    Config
+	storage invest.NotificationStorage
+	logger  *zap.Logger
+	senders map[string]Sender
+}
+
+// NewNotifier creates notifier with webhook sender and with email sender when it is configured.
+// Telegram channels are delivered by the bot, its sender is registered when the bot is enabled.
+func NewNotifier(conf Config, storage invest.NotificationStorage, logger *zap.Logger) (*Notifier, error) {
+
+	if storage == nil {
+		return nil, errors.New("storage provided to notifier is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger provided to notifier is nil")
+	}
+
+	if conf.Attempts <= 0 {
+		conf.Attempts = defaultAttempts
+	}
+	if conf.Backoff <= 0 {
+		conf.Backoff = defaultBackoff
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = defaultTimeout
+	}
+
+	n := &Notifier{
+		conf:    conf,
+		storage: storage,
+		logger:  logger.With(zap.String("service", "notifier")),
+		senders: make(map[string]Sender),
+	}
+	n.Register(ChannelWebhook, NewWebhookSender(conf.Timeout))
+	if conf.SMTP.Host != "" {
+		n.Register(ChannelEmail, NewSMTPSender(conf.SMTP, conf.Timeout))
+	}
+
+	return n, nil
+}
+
+// Timeout returns timeout of a single delivery attempt, senders registered later should respect it.
+func (n *Notifier) Timeout() time.Duration {
+	return n.conf.Timeout
+}
+
+// Register sets sender of channel type, it replaces the built-in one. It must not be called concurrently with Notify.
+func (n *Notifier) Register(channelType string, sender Sender) {
+	n.senders[channelType] = sender
+}
+
+// Notify delivers notification to channels of all users concurrently and waits for all deliveries,
+// failed deliveries are logged and stored as dead letters.
+func (n *Notifier) Notify(ctx context.Context, notification invest.Notification) error {
+
+	channels, err := n.storage.NotificationChannels(ctx, "")
+	if err != nil {
+		return fmt.Errorf("load notification channels: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, channel := range channels {
+		if !channel.Enabled || !channel.Subscribed(notification.Kind) {
+			continue
+		}
+		sender, found := n.senders[channel.Type]
+		if !found {
+			n.deadLetter(ctx, channel, notification, 0, fmt.Errorf("channel type %q is not configured", channel.Type))
+			continue
+		}
+		wg.Add(1)
+		go func(channel invest.NotificationChannel) {
+			defer wg.Done()
+			n.deliver(ctx, sender, channel, notification)
+		}(channel)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (n *Notifier) deliver(ctx context.Context, sender Sender, channel invest.NotificationChannel, notification invest.Notification) {
+
+	logger := n.logger.With(zap.Int64("channel", channel.ID), zap.String("type", channel.Type),
+		zap.String("kind", notification.Kind))
+
+	var (
+		err     error
+		attempt int
+		backoff = n.conf.Backoff
+	)
+	for attempt = 1; attempt <= n.conf.Attempts; attempt++ {
+		if err = sender.Send(ctx, channel, notification); err == nil {
+			logger.Debug("notification delivered", zap.Int("attempt", attempt))
+			return
+		}
+		logger.Warn("problem while delivering notification", zap.Int("attempt", attempt), zap.Error(err))
+
+		if IsPermanent(err) || attempt == n.conf.Attempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			err = fmt.Errorf("%v, retry cancelled: %w", err, ctx.Err())
+			n.deadLetter(ctx, channel, notification, attempt, err)
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	n.deadLetter(ctx, channel, notification, attempt, err)
+}
+
+func (n *Notifier) deadLetter(ctx context.Context, channel invest.NotificationChannel, notification invest.Notification,
+	attempts int, cause error) {
+
+	// dead letter is saved even when delivery was interrupted by shutdown
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), deadLetterTimeout)
+		defer cancel()
+	}
+
+	letter := &invest.DeadLetter{
+		ChannelID:   channel.ID,
+		ChannelType: channel.Type,
+		Target:      channel.Target,
+		Kind:        notification.Kind,
+		Subject:     notification.Subject,
+		Text:        notification.Text,
+		Error:       cause.Error(),
+		Attempts:    attempts,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	if err := n.storage.SaveDeadLetter(ctx, letter); err != nil {
+		n.logger.Error("problem while saving dead letter", zap.Int64("channel", channel.ID), zap.NamedError("cause", cause),
+			zap.Error(err))
+		return
+	}
+	n.logger.Error("notification was not delivered", zap.Int64("channel", channel.ID), zap.Int64("deadLetter", letter.ID),
+		zap.Error(cause))
+}
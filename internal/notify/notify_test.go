@@ -0,0 +1,385 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"io/ioutil"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testStorage struct {
+	invest.Storage
+	mu          sync.Mutex
+	channels    []invest.NotificationChannel
+	deadLetters []invest.DeadLetter
+}
+
+func (s *testStorage) NotificationChannels(_ context.Context, user string) ([]invest.NotificationChannel, error) {
+	var channels []invest.NotificationChannel
+	for _, c := range s.channels {
+		if user == "" || c.User == user {
+			channels = append(channels, c)
+		}
+	}
+	return channels, nil
+}
+
+func (s *testStorage) SaveNotificationChannel(_ context.Context, channel *invest.NotificationChannel) error {
+	if channel.ID == 0 {
+		channel.ID = int64(len(s.channels) + 1)
+		s.channels = append(s.channels, *channel)
+		return nil
+	}
+	for i, c := range s.channels {
+		if c.ID == channel.ID {
+			s.channels[i] = *channel
+			return nil
+		}
+	}
+	return invest.ErrNotFound
+}
+
+func (s *testStorage) SaveDeadLetter(_ context.Context, letter *invest.DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	letter.ID = int64(len(s.deadLetters) + 1)
+	s.deadLetters = append(s.deadLetters, *letter)
+	return nil
+}
+
+func TestWebhook(t *testing.T) {
+
+	var (
+		mu       sync.Mutex
+		requests int
+		payload  WebhookPayload
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		// the first attempt fails and is retried
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Header.Get(HeaderSignature) != Sign("secret", r.Header.Get(HeaderTimestamp), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.Unmarshal(body, &payload)
+	}))
+	defer server.Close()
+
+	storage := &testStorage{channels: []invest.NotificationChannel{
+		{ID: 1, Type: ChannelWebhook, Target: server.URL, Secret: "secret", Enabled: true},
+		{ID: 2, Type: ChannelWebhook, Target: server.URL, Kinds: []string{invest.NotificationStatement}, Enabled: true},
+		{ID: 3, Type: ChannelWebhook, Target: server.URL},
+	}}
+	notifier := newTestNotifier(t, Config{}, storage)
+
+	err := notifier.Notify(context.Background(), invest.Notification{
+		Kind:    invest.NotificationAlert,
+		Subject: "Alert: price above",
+		Text:    "SBER price 300 RUB is above 250",
+		Data:    map[string]int{"ruleId": 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("want one failed and one successful request, got %d", requests)
+	}
+	if payload.Kind != invest.NotificationAlert || payload.Text != "SBER price 300 RUB is above 250" || payload.SentAt == "" {
+		t.Errorf("unexpected payload %+v", payload)
+	}
+	if len(storage.deadLetters) != 0 {
+		t.Errorf("unexpected dead letters %v", storage.deadLetters)
+	}
+}
+
+func TestDeadLetters(t *testing.T) {
+
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	storage := &testStorage{channels: []invest.NotificationChannel{
+		{ID: 1, Type: ChannelWebhook, Target: server.URL + "/gone", Enabled: true},
+		{ID: 2, Type: ChannelWebhook, Target: server.URL + "/unavailable", Enabled: true},
+		{ID: 3, Type: ChannelEmail, Target: "user@example.com", Enabled: true},
+	}}
+	notifier := newTestNotifier(t, Config{Attempts: 3}, storage)
+
+	if err := notifier.Notify(context.Background(), invest.Notification{Kind: invest.NotificationAlert, Text: "text"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// permanent failure is not retried
+	if requests != 4 {
+		t.Errorf("want 1 request to gone and 3 to unavailable webhook, got %d", requests)
+	}
+	attempts := make(map[int64]int)
+	for _, l := range storage.deadLetters {
+		attempts[l.ChannelID] = l.Attempts
+	}
+	if len(storage.deadLetters) != 3 || attempts[1] != 1 || attempts[2] != 3 || attempts[3] != 0 {
+		t.Errorf("unexpected dead letters %+v", storage.deadLetters)
+	}
+}
+
+func TestSMTP(t *testing.T) {
+
+	server := newSMTPServer(t)
+	host, port, _ := net.SplitHostPort(server.addr)
+	portNumber, _ := strconv.Atoi(port)
+	sender := NewSMTPSender(SMTPConfig{Host: host, Port: portNumber, From: "invest@example.com"}, time.Second)
+
+	err := sender.Send(context.Background(), invest.NotificationChannel{Target: "user@example.com"}, invest.Notification{
+		Subject: "Выписка за март 2021",
+		Text:    "Statement of account 1 is ready — see it in the app.",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mail := <-server.mails
+	if mail.from != "invest@example.com" || mail.to != "user@example.com" {
+		t.Errorf("unexpected envelope %s -> %s", mail.from, mail.to)
+	}
+	header, body := splitMail(t, mail.data)
+	var subject string
+	for _, line := range strings.Split(header, "\n") {
+		if strings.HasPrefix(line, "Subject: ") {
+			subject, _ = new(mime.WordDecoder).DecodeHeader(strings.TrimPrefix(line, "Subject: "))
+		}
+	}
+	if subject != "Выписка за март 2021" {
+		t.Errorf("unexpected subject %q in header %s", subject, header)
+	}
+	if body != "Statement of account 1 is ready — see it in the app." {
+		t.Errorf("unexpected body %q", body)
+	}
+
+	// rejected recipient is not retried
+	err = sender.Send(context.Background(), invest.NotificationChannel{Target: "unknown@example.com"}, invest.Notification{})
+	var permanent permanentError
+	if !errors.As(err, &permanent) {
+		t.Errorf("want permanent error, got %v", err)
+	}
+}
+
+func TestSMTPTimeout(t *testing.T) {
+
+	// server accepts connections but never greets
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+
+	sender := NewSMTPSender(SMTPConfig{Host: host, Port: portNumber, From: "invest@example.com"}, 100*time.Millisecond)
+	start := time.Now()
+	err = sender.Send(context.Background(), invest.NotificationChannel{Target: "user@example.com"}, invest.Notification{})
+	if err == nil || time.Since(start) > time.Second {
+		t.Errorf("want timeout error, got %v after %s", err, time.Since(start))
+	}
+
+	// cancellation interrupts delivery before timeout
+	sender = NewSMTPSender(SMTPConfig{Host: host, Port: portNumber, From: "invest@example.com"}, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	if err := sender.Send(ctx, invest.NotificationChannel{Target: "user@example.com"}, invest.Notification{}); err == nil ||
+		time.Since(start) > time.Second {
+		t.Errorf("want cancellation error, got %v after %s", err, time.Since(start))
+	}
+}
+
+func TestSaveChannel(t *testing.T) {
+
+	storage := &testStorage{}
+	tests := []struct {
+		name    string
+		channel *pb.NotificationChannel
+		ok      bool
+	}{
+		{"nil", nil, false},
+		{"webhook", &pb.NotificationChannel{User: "ivan", Type: ChannelWebhook, Target: "https://example.com/hook"}, true},
+		{"webhook without scheme", &pb.NotificationChannel{User: "ivan", Type: ChannelWebhook, Target: "example.com"}, false},
+		{"email", &pb.NotificationChannel{User: "ivan", Type: ChannelEmail, Target: "Ivan <ivan@example.com>"}, true},
+		{"invalid email", &pb.NotificationChannel{User: "ivan", Type: ChannelEmail, Target: "ivan"}, false},
+		{"telegram", &pb.NotificationChannel{User: "ivan", Type: ChannelTelegram, Target: "42", Kinds: []string{"alert"}}, true},
+		{"unknown kind", &pb.NotificationChannel{User: "ivan", Type: ChannelTelegram, Target: "42", Kinds: []string{"news"}}, false},
+		{"without user", &pb.NotificationChannel{Type: ChannelTelegram, Target: "42"}, false},
+		{"unknown type", &pb.NotificationChannel{User: "ivan", Type: "sms", Target: "+70000000000"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SaveChannel(context.Background(), storage, &pb.SaveNotificationChannelRequest{Channel: tt.channel})
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, invest.ErrInvalidArgument) {
+				t.Fatalf("want invalid argument, got %v", err)
+			}
+		})
+	}
+
+	// update without secret keeps the stored one
+	storage.channels[0].Secret = "secret"
+	resp, err := SaveChannel(context.Background(), storage, &pb.SaveNotificationChannelRequest{Channel: &pb.NotificationChannel{
+		Id: 1, User: "ivan", Type: ChannelWebhook, Target: "https://example.com/new",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if storage.channels[0].Secret != "secret" || resp.Channel.Secret != "" || resp.Channel.Target != "https://example.com/new" {
+		t.Errorf("unexpected channel %+v, response %v", storage.channels[0], resp.Channel)
+	}
+
+	_, err = SaveChannel(context.Background(), storage, &pb.SaveNotificationChannelRequest{Channel: &pb.NotificationChannel{
+		Id: 10, User: "ivan", Type: ChannelTelegram, Target: "42",
+	}})
+	if !errors.Is(err, invest.ErrNotFound) {
+		t.Errorf("want not found, got %v", err)
+	}
+}
+
+func newTestNotifier(t *testing.T, conf Config, storage invest.NotificationStorage) *Notifier {
+	t.Helper()
+	conf.Backoff = time.Millisecond
+	notifier, err := NewNotifier(conf, storage, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return notifier
+}
+
+type testMail struct {
+	from, to, data string
+}
+
+type smtpServer struct {
+	addr  string
+	mails chan testMail
+}
+
+// newSMTPServer starts plain SMTP server which accepts mail for any recipient except unknown@example.com.
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := &smtpServer{addr: listener.Addr().String(), mails: make(chan testMail, 10)}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(textproto.NewConn(conn))
+		}
+	}()
+	return server
+}
+
+func (s *smtpServer) serve(conn *textproto.Conn) {
+	defer conn.Close()
+
+	var mail testMail
+	_ = conn.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			_ = conn.PrintfLine("250-localhost")
+			_ = conn.PrintfLine("250 8BITMIME")
+		case "MAIL":
+			mail.from = strings.Trim(strings.TrimPrefix(line, "MAIL FROM:"), "<>")
+			mail.from = strings.SplitN(mail.from, ">", 2)[0]
+			_ = conn.PrintfLine("250 OK")
+		case "RCPT":
+			mail.to = strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>")
+			if mail.to == "unknown@example.com" {
+				_ = conn.PrintfLine("550 No such user")
+				continue
+			}
+			_ = conn.PrintfLine("250 OK")
+		case "DATA":
+			_ = conn.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := conn.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.data = string(data)
+			s.mails <- mail
+			_ = conn.PrintfLine("250 OK")
+		case "QUIT":
+			_ = conn.PrintfLine("221 Bye")
+			return
+		default:
+			_ = conn.PrintfLine("250 OK")
+		}
+	}
+}
+
+func splitMail(t *testing.T, data string) (header, body string) {
+	t.Helper()
+	parts := strings.SplitN(data, "\n\n", 2)
+	if len(parts) != 2 {
+		t.Fatalf("mail without body: %q", data)
+	}
+	decoded, err := ioutil.ReadAll(quotedprintable.NewReader(strings.NewReader(parts[1])))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parts[0], strings.TrimSpace(string(decoded))
+}
@@ -0,0 +1,156 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"goinvest/internal/invest"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+)
+
+const defaultSMTPPort = 587
+
+// SMTPConfig configures email delivery, authentication is skipped when username is empty.
+// Connection is upgraded with STARTTLS when server supports it.
+type SMTPConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port" validate:"gte=0,lte=65535"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password" secret:"true"`
+	From     string `yaml:"from"`
+}
+
+// smtpSender emails notifications to channel address.
+type smtpSender struct {
+	conf    SMTPConfig
+	timeout time.Duration
+}
+
+// NewSMTPSender returns sender which bounds delivery of a single email by timeout and context.
+func NewSMTPSender(conf SMTPConfig, timeout time.Duration) Sender {
+	if conf.Port == 0 {
+		conf.Port = defaultSMTPPort
+	}
+	return &smtpSender{conf: conf, timeout: timeout}
+}
+
+func (s *smtpSender) Send(ctx context.Context, channel invest.NotificationChannel, notification invest.Notification) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	msg, err := emailMessage(s.conf.From, channel.Target, notification, time.Now())
+	if err != nil {
+		return Permanent(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	if err := s.sendMail(ctx, channel.Target, msg); err != nil {
+		// 5xx replies are permanent failures, e.g. unknown recipient
+		var reply *textproto.Error
+		if errors.As(err, &reply) && reply.Code >= 500 {
+			return Permanent(fmt.Errorf("send email: %w", err))
+		}
+		return fmt.Errorf("send email: %w", err)
+	}
+	return nil
+}
+
+// sendMail is smtp.SendMail bounded by context: connection deadline is set from context
+// and connection is closed when context is done.
+func (s *smtpSender) sendMail(ctx context.Context, to string, msg []byte) error {
+
+	addr := net.JoinHostPort(s.conf.Host, strconv.Itoa(s.conf.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	c, err := smtp.NewClient(conn, s.conf.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.conf.Host}); err != nil {
+			return err
+		}
+	}
+	if s.conf.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support authentication")
+		}
+		if err := c.Auth(smtp.PlainAuth("", s.conf.Username, s.conf.Password, s.conf.Host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(s.conf.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// emailMessage builds plain text message with quoted-printable body.
+func emailMessage(from, to string, notification invest.Notification, date time.Time) ([]byte, error) {
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(notification.Text)); err != nil {
+		return nil, fmt.Errorf("encode email body: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("encode email body: %w", err)
+	}
+	buf.WriteString("\r\n")
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,99 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"goinvest/internal/invest"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Webhook request headers, signature is present only when channel has a secret.
+const (
+	HeaderTimestamp = "X-Invest-Timestamp"
+	HeaderSignature = "X-Invest-Signature"
+)
+
+// WebhookPayload is a JSON body posted to webhook channels.
+type WebhookPayload struct {
+	Kind    string      `json:"kind"`
+	Subject string      `json:"subject"`
+	Text    string      `json:"text"`
+	Data    interface{} `json:"data,omitempty"`
+	SentAt  string      `json:"sentAt"`
+}
+
+// webhookSender posts notifications as JSON to channel url.
+type webhookSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewWebhookSender(timeout time.Duration) Sender {
+	return &webhookSender{client: &http.Client{Timeout: timeout}, now: time.Now}
+}
+
+func (s *webhookSender) Send(ctx context.Context, channel invest.NotificationChannel, notification invest.Notification) error {
+
+	now := s.now().UTC()
+	body, err := json.Marshal(WebhookPayload{
+		Kind:    notification.Kind,
+		Subject: notification.Subject,
+		Text:    notification.Text,
+		Data:    notification.Data,
+		SentAt:  now.Format(time.RFC3339),
+	})
+	if err != nil {
+		return Permanent(fmt.Errorf("marshal webhook payload: %w", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, channel.Target, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(fmt.Errorf("create webhook request: %w", err))
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, timestamp)
+	if channel.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(channel.Secret, timestamp, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	if PermanentStatus(resp.StatusCode) {
+		return Permanent(err)
+	}
+	return err
+}
+
+// Sign returns signature of webhook request, it is HMAC-SHA256 of timestamp and body joined with a dot.
+// Receivers should recompute it and reject stale timestamps to prevent replays.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// PermanentStatus reports client errors other than throttling and timeout, retrying them is useless.
+func PermanentStatus(code int) bool {
+	return code >= 400 && code < 500 && code != http.StatusTooManyRequests && code != http.StatusRequestTimeout
+}
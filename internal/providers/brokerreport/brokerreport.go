@@ -0,0 +1,98 @@
+// Package brokerreport implements provider of accounts at brokers without API. Accounts, positions and
+// operations are imported from broker statements by format specific parsers and stored in invest.LedgerStorage.
+package brokerreport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"goinvest/internal/providers/ledger"
+)
+
+// defaultFormat is used when import request does not specify statement format.
+const defaultFormat = FormatCSV
+
+type providerBrokerReport struct {
+	*ledger.Provider
+	logger *zap.Logger
+}
+
+// NewBrokerReport is a constructor-like function which constructs broker report provider.
+func NewBrokerReport(storage invest.LedgerStorage, logger *zap.Logger) (invest.Provider, error) {
+
+	if logger == nil {
+		return nil, fmt.Errorf("provider %s: logger must be provided", ProviderID)
+	}
+
+	ledgerProvider, err := ledger.New(ProviderID, storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerBrokerReport{
+		Provider: ledgerProvider,
+		logger:   logger,
+	}, nil
+}
+
+// Import parses statement and stores accounts it contains. Positions snapshot of account is replaced
+// only when statement contains positions, operations are upserted by their ids, so the same statement
+// can be imported repeatedly.
+func (p *providerBrokerReport) Import(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error) {
+
+	format := req.Format
+	if format == "" {
+		format = defaultFormat
+	}
+	parser, found := ParserByFormat(format)
+	if !found {
+		return nil, fmt.Errorf("%w: unknown statement format %q", invest.ErrInvalidArgument, format)
+	}
+
+	statements, err := parser.Parse(bytes.NewReader(req.Content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse %s statement: %s", invest.ErrInvalidArgument, format, err)
+	}
+
+	resp := &pb.ImportStatementResponse{}
+	for _, statement := range statements {
+		account := statement.Account
+		if account.AccountID == "" {
+			return nil, fmt.Errorf("%w: statement account is required", invest.ErrInvalidArgument)
+		}
+		account.Provider = p.ID
+
+		if err := p.Storage.SaveLedgerAccount(ctx, account); err != nil {
+			return nil, err
+		}
+		if statement.Positions != nil {
+			if err := p.Storage.ReplaceLedgerPositions(ctx, p.ID, account.AccountID, statement.Positions); err != nil {
+				return nil, err
+			}
+		}
+		if len(statement.Operations) > 0 {
+			if err := p.Storage.SaveLedgerOperations(ctx, p.ID, account.AccountID, statement.Operations); err != nil {
+				return nil, err
+			}
+		}
+
+		resp.Accounts = append(resp.Accounts, &pb.Account{
+			AccountId:   account.AccountID,
+			AccountType: account.AccountType,
+			Provider:    p.ID.String(),
+		})
+		resp.Positions += int32(len(statement.Positions))
+		resp.Operations += int32(len(statement.Operations))
+	}
+
+	p.logger.Info("broker statement imported",
+		zap.String("format", format),
+		zap.Int("accounts", len(resp.Accounts)),
+		zap.Int32("positions", resp.Positions),
+		zap.Int32("operations", resp.Operations))
+
+	return resp, nil
+}
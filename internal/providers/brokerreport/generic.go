@@ -0,0 +1,291 @@
+package brokerreport
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"goinvest/internal/xlsx"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats of generic statement schema.
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+const (
+	recordPosition  = "position"
+	recordOperation = "operation"
+)
+
+func init() {
+	RegisterParser(FormatCSV, ParserFunc(ParseCSV))
+	RegisterParser(FormatXLSX, ParserFunc(ParseXLSX))
+}
+
+// ParseCSV parses statement of generic schema from CSV, comma and semicolon separators are supported.
+func ParseCSV(r io.Reader) ([]Statement, error) {
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if header := data[:indexOrLen(data, '\n')]; bytes.Count(header, []byte{';'}) > bytes.Count(header, []byte{','}) {
+		reader.Comma = ';'
+	}
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return ParseRows(rows)
+}
+
+// ParseXLSX parses statement of generic schema from the first worksheet of XLSX workbook.
+func ParseXLSX(r io.Reader) ([]Statement, error) {
+	rows, err := xlsx.Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRows(rows)
+}
+
+// ParseRows parses statement of generic schema. The first row is a header, column names are case-insensitive:
+//
+//	record          - "position" or "operation", required
+//	account         - account id, required
+//	account_type    - "broker" or "iis"
+//	account_name    - human readable account name
+//	figi, ticker, isin, name, instrument_type - instrument, cash is a position of "Currency" instrument type
+//	balance, average_price, price             - position balance, average purchase and market prices
+//	id, date, operation_type, quantity, payment, commission - operation, price is shared with positions
+//	currency        - currency of prices and payments
+//
+// Operation type is an OperationType name without prefix, e.g. "buy" or "dividend". Operations without id
+// get an id derived from their content, so reimport of the same statement does not duplicate them.
+func ParseRows(rows [][]string) ([]Statement, error) {
+
+	if len(rows) == 0 {
+		return nil, errors.New("statement is empty")
+	}
+
+	columns := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"record", "account"} {
+		if _, found := columns[required]; !found {
+			return nil, fmt.Errorf("required column %s is missing", required)
+		}
+	}
+
+	var (
+		statements = make(map[string]*Statement)
+		accounts   []string
+	)
+	for n, row := range rows[1:] {
+		line := n + 2
+		get := func(column string) string {
+			if i, found := columns[column]; found && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		number := func(column string) (float64, error) {
+			value, err := parseNumber(get(column))
+			if err != nil {
+				return 0, fmt.Errorf("line %d: column %s: %w", line, column, err)
+			}
+			return value, nil
+		}
+
+		record := strings.ToLower(get("record"))
+		if record == "" {
+			continue
+		}
+
+		accountID := get("account")
+		if accountID == "" {
+			return nil, fmt.Errorf("line %d: account is required", line)
+		}
+		statement, found := statements[accountID]
+		if !found {
+			statement = &Statement{Account: invest.LedgerAccount{AccountID: accountID}}
+			statements[accountID] = statement
+			accounts = append(accounts, accountID)
+		}
+		if accountType := get("account_type"); accountType != "" {
+			statement.Account.AccountType = parseAccountType(accountType)
+		}
+		if name := get("account_name"); name != "" {
+			statement.Account.Name = name
+		}
+
+		switch record {
+		case recordPosition:
+			balance, err := number("balance")
+			if err != nil {
+				return nil, err
+			}
+			averagePrice, err := number("average_price")
+			if err != nil {
+				return nil, err
+			}
+			price, err := number("price")
+			if err != nil {
+				return nil, err
+			}
+			position := invest.LedgerPosition{
+				Figi:           get("figi"),
+				Ticker:         get("ticker"),
+				Isin:           get("isin"),
+				Name:           get("name"),
+				InstrumentType: get("instrument_type"),
+				Balance:        balance,
+				AveragePrice:   averagePrice,
+				Price:          price,
+				Currency:       strings.ToUpper(get("currency")),
+			}
+			if position.InstrumentType == invest.InstrumentTypeCurrency && position.Ticker == "" {
+				position.Ticker = position.Currency
+			}
+			if position.Key() == "" {
+				return nil, fmt.Errorf("line %d: position figi, isin or ticker is required", line)
+			}
+			statement.Positions = append(statement.Positions, position)
+
+		case recordOperation:
+			operationType, found := pb.OperationType_value["OPERATION_TYPE_"+strings.ToUpper(get("operation_type"))]
+			if !found {
+				return nil, fmt.Errorf("line %d: unknown operation type %q", line, get("operation_type"))
+			}
+			date, err := parseDate(get("date"))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			quantity, err := parseQuantity(get("quantity"))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: column quantity: %w", line, err)
+			}
+			price, err := number("price")
+			if err != nil {
+				return nil, err
+			}
+			payment, err := number("payment")
+			if err != nil {
+				return nil, err
+			}
+			commission, err := number("commission")
+			if err != nil {
+				return nil, err
+			}
+			id := get("id")
+			if id == "" {
+				id = rowID(row)
+			}
+			statement.Operations = append(statement.Operations, invest.LedgerOperation{
+				ID:             id,
+				OperationType:  pb.OperationType(operationType),
+				Figi:           get("figi"),
+				Ticker:         get("ticker"),
+				Isin:           get("isin"),
+				InstrumentType: get("instrument_type"),
+				Date:           date,
+				Quantity:       quantity,
+				Price:          price,
+				Payment:        payment,
+				Currency:       strings.ToUpper(get("currency")),
+				Commission:     commission,
+			})
+
+		default:
+			return nil, fmt.Errorf("line %d: unknown record %q", line, record)
+		}
+	}
+
+	sort.Strings(accounts)
+	result := make([]Statement, 0, len(accounts))
+	for _, accountID := range accounts {
+		result = append(result, *statements[accountID])
+	}
+	return result, nil
+}
+
+func parseAccountType(value string) pb.AccountType {
+	switch strings.ToLower(value) {
+	case "iis":
+		return pb.AccountType_TYPE_IIS
+	case "broker":
+		return pb.AccountType_TYPE_BROKER
+	default:
+		return pb.AccountType_TYPE_UNSPECIFIED
+	}
+}
+
+// parseNumber parses number which may use spaces as thousands separator and comma as decimal separator.
+func parseNumber(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	value = strings.NewReplacer(" ", "", "\u00a0", "").Replace(value)
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseQuantity parses quantity of securities, fractional quantities are rejected as operations keep whole units.
+func parseQuantity(value string) (int32, error) {
+	quantity, err := parseNumber(value)
+	if err != nil {
+		return 0, err
+	}
+	if quantity != math.Trunc(quantity) {
+		return 0, fmt.Errorf("fractional quantity %s is not supported", value)
+	}
+	if quantity > math.MaxInt32 || quantity < math.MinInt32 {
+		return 0, fmt.Errorf("quantity %s is out of range", value)
+	}
+	return int32(quantity), nil
+}
+
+var dateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02", "02.01.2006 15:04:05", "02.01.2006"}
+
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if date, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse date %q", value)
+}
+
+// rowID derives stable operation id from row content.
+func rowID(row []string) string {
+	sum := sha1.Sum([]byte(strings.Join(row, "\x1f")))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+func indexOrLen(b []byte, c byte) int {
+	if i := bytes.IndexByte(b, c); i >= 0 {
+		return i
+	}
+	return len(b)
+}
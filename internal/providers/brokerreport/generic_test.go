@@ -0,0 +1,121 @@
+package brokerreport
+
+import (
+	"archive/zip"
+	"bytes"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const statementCSV = `record;account;account_type;figi;ticker;instrument_type;balance;average_price;price;currency;date;operation_type;quantity;payment;commission
+position;B-1;iis;BBG000B9XRY4;AAPL;Stock;10;100,5;120;usd;;;;;
+position;B-1;;;;Currency;1 000,25;;;rub;;;;;
+operation;B-1;;BBG000B9XRY4;AAPL;Stock;;;100,5;usd;2021-03-01;buy;10;-1005;1,5
+operation;A-2;broker;;;;;;;rub;2021-03-02T10:00:00Z;pay_in;;5000;
+`
+
+func TestParseCSV(t *testing.T) {
+
+	statements, err := ParseCSV(strings.NewReader(statementCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 2 {
+		t.Fatalf("unexpected statements %v", statements)
+	}
+
+	payIn := statements[0]
+	if payIn.Account.AccountID != "A-2" || payIn.Account.AccountType != pb.AccountType_TYPE_BROKER || payIn.Positions != nil {
+		t.Errorf("unexpected statement %v", payIn)
+	}
+	if len(payIn.Operations) != 1 || payIn.Operations[0].ID == "" || payIn.Operations[0].Payment != 5000 {
+		t.Errorf("unexpected operations %v", payIn.Operations)
+	}
+
+	iis := statements[1]
+	if iis.Account.AccountType != pb.AccountType_TYPE_IIS || len(iis.Positions) != 2 || len(iis.Operations) != 1 {
+		t.Fatalf("unexpected statement %v", iis)
+	}
+	stock, cash := iis.Positions[0], iis.Positions[1]
+	if stock.Key() != "BBG000B9XRY4" || stock.AveragePrice != 100.5 || stock.Price != 120 || stock.Currency != "USD" {
+		t.Errorf("unexpected stock position %v", stock)
+	}
+	if cash.InstrumentType != invest.InstrumentTypeCurrency || cash.Key() != "RUB" || cash.Balance != 1000.25 {
+		t.Errorf("unexpected cash position %v", cash)
+	}
+	buy := iis.Operations[0]
+	if buy.OperationType != pb.OperationType_OPERATION_TYPE_BUY || buy.Quantity != 10 || buy.Commission != 1.5 ||
+		!buy.Date.Equal(time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected buy operation %v", buy)
+	}
+
+	again, err := ParseCSV(strings.NewReader(statementCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again[0].Operations[0].ID != payIn.Operations[0].ID {
+		t.Error("generated operation id is not stable")
+	}
+}
+
+func TestParseRowsErrors(t *testing.T) {
+
+	tests := []struct {
+		name string
+		csv  string
+	}{
+		{"missing column", "account\nA\n"},
+		{"unknown record", "record,account\ntrade,A\n"},
+		{"unknown operation", "record,account,operation_type,date\noperation,A,gift,2021-01-01\n"},
+		{"bad date", "record,account,operation_type,date\noperation,A,buy,yesterday\n"},
+		{"position without instrument", "record,account,balance\nposition,A,1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCSV(strings.NewReader(tt.csv)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestParseXLSX(t *testing.T) {
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	files := map[string]string{
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<si><t>record</t></si><si><t>account</t></si><si><t>ticker</t></si><si><t>balance</t></si>` +
+			`<si><t>position</t></si><si><r><t>A-</t></r><r><t>1</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c><c r="D1" t="s"><v>3</v></c></row>` +
+			`<row r="2"><c r="A2" t="s"><v>4</v></c><c r="B2" t="s"><v>5</v></c><c r="C2" t="inlineStr"><is><t>SBER</t></is></c><c r="D2"><v>42</v></c></row>` +
+			`</sheetData></worksheet>`,
+	}
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	statements, err := ParseXLSX(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 1 || statements[0].Account.AccountID != "A-1" || len(statements[0].Positions) != 1 {
+		t.Fatalf("unexpected statements %v", statements)
+	}
+	if position := statements[0].Positions[0]; position.Ticker != "SBER" || position.Balance != 42 {
+		t.Errorf("unexpected position %v", position)
+	}
+}
@@ -0,0 +1,368 @@
+package brokerreport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+// FormatIBKRFlex is a format of Interactive Brokers Flex Query XML statements.
+const FormatIBKRFlex = "ibkr_flex"
+
+func init() {
+	RegisterParser(FormatIBKRFlex, ParserFunc(ParseIBKRFlex))
+}
+
+// ibkrInstrument holds instrument attributes shared by all Flex Query rows.
+type ibkrInstrument struct {
+	Currency       string `xml:"currency,attr"`
+	AssetCategory  string `xml:"assetCategory,attr"`
+	Symbol         string `xml:"symbol,attr"`
+	Description    string `xml:"description,attr"`
+	SecurityID     string `xml:"securityID,attr"`
+	SecurityIDType string `xml:"securityIDType,attr"`
+	Isin           string `xml:"isin,attr"`
+	Figi           string `xml:"figi,attr"`
+	LevelOfDetail  string `xml:"levelOfDetail,attr"`
+}
+
+type ibkrFlexQueryResponse struct {
+	XMLName    xml.Name `xml:"FlexQueryResponse"`
+	Statements []struct {
+		AccountID          string `xml:"accountId,attr"`
+		AccountInformation struct {
+			AccountID string `xml:"accountId,attr"`
+			Name      string `xml:"name,attr"`
+		} `xml:"AccountInformation"`
+		Trades []struct {
+			ibkrInstrument
+			TransactionID string `xml:"transactionID,attr"`
+			TradeID       string `xml:"tradeID,attr"`
+			TradeDate     string `xml:"tradeDate,attr"`
+			DateTime      string `xml:"dateTime,attr"`
+			Quantity      string `xml:"quantity,attr"`
+			TradePrice    string `xml:"tradePrice,attr"`
+			Proceeds      string `xml:"proceeds,attr"`
+			IBCommission  string `xml:"ibCommission,attr"`
+			BuySell       string `xml:"buySell,attr"`
+		} `xml:"Trades>Trade"`
+		CashTransactions []struct {
+			ibkrInstrument
+			TransactionID string `xml:"transactionID,attr"`
+			DateTime      string `xml:"dateTime,attr"`
+			Amount        string `xml:"amount,attr"`
+			Type          string `xml:"type,attr"`
+		} `xml:"CashTransactions>CashTransaction"`
+		OpenPositions *struct {
+			Items []struct {
+				ibkrInstrument
+				Position       string `xml:"position,attr"`
+				MarkPrice      string `xml:"markPrice,attr"`
+				CostBasisPrice string `xml:"costBasisPrice,attr"`
+			} `xml:"OpenPosition"`
+		} `xml:"OpenPositions"`
+		CashReport *struct {
+			Items []struct {
+				Currency      string `xml:"currency,attr"`
+				EndingCash    string `xml:"endingCash,attr"`
+				LevelOfDetail string `xml:"levelOfDetail,attr"`
+			} `xml:"CashReportCurrency"`
+		} `xml:"CashReport"`
+		CorporateActions []struct {
+			ibkrInstrument
+			TransactionID string `xml:"transactionID,attr"`
+			ActionID      string `xml:"actionID,attr"`
+			DateTime      string `xml:"dateTime,attr"`
+			ReportDate    string `xml:"reportDate,attr"`
+			Type          string `xml:"type,attr"`
+			Quantity      string `xml:"quantity,attr"`
+			Proceeds      string `xml:"proceeds,attr"`
+		} `xml:"CorporateActions>CorporateAction"`
+	} `xml:"FlexStatements>FlexStatement"`
+}
+
+// ParseIBKRFlex parses Interactive Brokers Flex Query XML statement with trades, cash transactions,
+// open positions, cash report and corporate actions sections. Every FlexStatement becomes a statement
+// of its account. Positions are reported only when statement contains open positions or cash report,
+// currency conversions and unknown cash transaction types are skipped. Corporate actions become
+// SECURITY_OUT and SECURITY_IN operations of the same time, so tax.Report carries cost of removed
+// securities to received ones. Fractional quantities of trades and corporate actions are rejected.
+func ParseIBKRFlex(r io.Reader) ([]Statement, error) {
+
+	var response ibkrFlexQueryResponse
+	if err := xml.NewDecoder(r).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode flex query: %w", err)
+	}
+	if len(response.Statements) == 0 {
+		return nil, errors.New("flex query contains no statements")
+	}
+
+	statements := make([]Statement, 0, len(response.Statements))
+	for _, flex := range response.Statements {
+		accountID := flex.AccountID
+		if accountID == "" {
+			accountID = flex.AccountInformation.AccountID
+		}
+		statement := Statement{
+			Account: invest.LedgerAccount{
+				AccountID:   accountID,
+				AccountType: pb.AccountType_TYPE_BROKER,
+				Name:        flex.AccountInformation.Name,
+			},
+		}
+
+		for _, trade := range flex.Trades {
+			if !ibkrLevel(trade.LevelOfDetail, "EXECUTION") || trade.AssetCategory == "CASH" {
+				continue
+			}
+			date, err := parseIBKRDate(firstNonEmpty(trade.DateTime, trade.TradeDate))
+			if err != nil {
+				return nil, fmt.Errorf("trade %s: %w", trade.TradeID, err)
+			}
+			quantity, err := parseQuantity(trade.Quantity)
+			if err != nil {
+				return nil, fmt.Errorf("trade %s: %w", trade.TradeID, err)
+			}
+			price, err := parseNumber(trade.TradePrice)
+			if err != nil {
+				return nil, fmt.Errorf("trade %s: %w", trade.TradeID, err)
+			}
+			proceeds, err := parseNumber(trade.Proceeds)
+			if err != nil {
+				return nil, fmt.Errorf("trade %s: %w", trade.TradeID, err)
+			}
+			commission, err := parseNumber(trade.IBCommission)
+			if err != nil {
+				return nil, fmt.Errorf("trade %s: %w", trade.TradeID, err)
+			}
+			operationType := pb.OperationType_OPERATION_TYPE_BUY
+			if strings.HasPrefix(strings.ToUpper(trade.BuySell), "SELL") || quantity < 0 {
+				operationType = pb.OperationType_OPERATION_TYPE_SELL
+			}
+			statement.Operations = append(statement.Operations, invest.LedgerOperation{
+				ID:             firstNonEmpty(trade.TransactionID, trade.TradeID),
+				OperationType:  operationType,
+				Figi:           trade.Figi,
+				Ticker:         trade.Symbol,
+				Isin:           trade.isin(),
+				InstrumentType: trade.instrumentType(),
+				Date:           date,
+				Quantity:       abs(quantity),
+				Price:          price,
+				Payment:        proceeds,
+				Currency:       trade.Currency,
+				Commission:     math.Abs(commission),
+			})
+		}
+
+		for _, transaction := range flex.CashTransactions {
+			if !ibkrLevel(transaction.LevelOfDetail, "DETAIL") {
+				continue
+			}
+			amount, err := parseNumber(transaction.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("cash transaction %s: %w", transaction.TransactionID, err)
+			}
+			operationType, known := ibkrCashOperationType(transaction.Type, amount)
+			if !known {
+				continue
+			}
+			date, err := parseIBKRDate(transaction.DateTime)
+			if err != nil {
+				return nil, fmt.Errorf("cash transaction %s: %w", transaction.TransactionID, err)
+			}
+			statement.Operations = append(statement.Operations, invest.LedgerOperation{
+				ID:             transaction.TransactionID,
+				OperationType:  operationType,
+				Figi:           transaction.Figi,
+				Ticker:         transaction.Symbol,
+				Isin:           transaction.isin(),
+				InstrumentType: transaction.instrumentType(),
+				Date:           date,
+				Payment:        amount,
+				Currency:       transaction.Currency,
+			})
+		}
+
+		for _, action := range flex.CorporateActions {
+			if !ibkrLevel(action.LevelOfDetail, "DETAIL") {
+				continue
+			}
+			date, err := parseIBKRDate(firstNonEmpty(action.DateTime, action.ReportDate))
+			if err != nil {
+				return nil, fmt.Errorf("corporate action %s: %w", action.ActionID, err)
+			}
+			quantity, err := parseQuantity(action.Quantity)
+			if err != nil {
+				return nil, fmt.Errorf("corporate action %s: %w", action.ActionID, err)
+			}
+			proceeds, err := parseNumber(action.Proceeds)
+			if err != nil {
+				return nil, fmt.Errorf("corporate action %s: %w", action.ActionID, err)
+			}
+			operationType := pb.OperationType_OPERATION_TYPE_SECURITY_IN
+			if quantity < 0 {
+				operationType = pb.OperationType_OPERATION_TYPE_SECURITY_OUT
+			}
+			statement.Operations = append(statement.Operations, invest.LedgerOperation{
+				ID:             firstNonEmpty(action.TransactionID, action.ActionID+"-"+action.key()),
+				OperationType:  operationType,
+				Figi:           action.Figi,
+				Ticker:         action.Symbol,
+				Isin:           action.isin(),
+				InstrumentType: action.instrumentType(),
+				Date:           date,
+				Quantity:       abs(quantity),
+				Payment:        proceeds,
+				Currency:       action.Currency,
+			})
+		}
+
+		if flex.OpenPositions != nil || flex.CashReport != nil {
+			statement.Positions = []invest.LedgerPosition{}
+		}
+		if flex.OpenPositions != nil {
+			for _, position := range flex.OpenPositions.Items {
+				if !ibkrLevel(position.LevelOfDetail, "SUMMARY") {
+					continue
+				}
+				balance, err := parseNumber(position.Position)
+				if err != nil {
+					return nil, fmt.Errorf("open position %s: %w", position.Symbol, err)
+				}
+				markPrice, err := parseNumber(position.MarkPrice)
+				if err != nil {
+					return nil, fmt.Errorf("open position %s: %w", position.Symbol, err)
+				}
+				costBasisPrice, err := parseNumber(position.CostBasisPrice)
+				if err != nil {
+					return nil, fmt.Errorf("open position %s: %w", position.Symbol, err)
+				}
+				statement.Positions = append(statement.Positions, invest.LedgerPosition{
+					Figi:           position.Figi,
+					Ticker:         position.Symbol,
+					Isin:           position.isin(),
+					Name:           position.Description,
+					InstrumentType: position.instrumentType(),
+					Balance:        balance,
+					AveragePrice:   costBasisPrice,
+					Price:          markPrice,
+					Currency:       position.Currency,
+				})
+			}
+		}
+		if flex.CashReport != nil {
+			for _, cash := range flex.CashReport.Items {
+				// base currency summary duplicates balances of every currency
+				if cash.Currency == "" || cash.Currency == "BASE_SUMMARY" {
+					continue
+				}
+				balance, err := parseNumber(cash.EndingCash)
+				if err != nil {
+					return nil, fmt.Errorf("cash report %s: %w", cash.Currency, err)
+				}
+				statement.Positions = append(statement.Positions, invest.LedgerPosition{
+					Ticker:         cash.Currency,
+					InstrumentType: invest.InstrumentTypeCurrency,
+					Balance:        balance,
+					Currency:       cash.Currency,
+				})
+			}
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+// key identifies instrument by the first known of FIGI, ISIN and symbol, like LedgerPosition.Key.
+func (i ibkrInstrument) key() string {
+	return firstNonEmpty(i.Figi, i.isin(), i.Symbol)
+}
+
+func (i ibkrInstrument) isin() string {
+	if i.Isin == "" && strings.EqualFold(i.SecurityIDType, "ISIN") {
+		return i.SecurityID
+	}
+	return i.Isin
+}
+
+// instrumentType maps IBKR asset category to instrument types used by other providers.
+func (i ibkrInstrument) instrumentType() string {
+	switch i.AssetCategory {
+	case "STK":
+		return "Stock"
+	case "BOND":
+		return "Bond"
+	case "FUND":
+		return "Etf"
+	case "CASH":
+		return invest.InstrumentTypeCurrency
+	default:
+		return i.AssetCategory
+	}
+}
+
+// ibkrLevel reports whether row has the level of detail its section is imported at, rows without level are imported.
+// Other levels duplicate imported rows: orders and closed lots of trades, lots of open positions and summaries
+// of cash transactions and corporate actions.
+func ibkrLevel(levelOfDetail, imported string) bool {
+	return levelOfDetail == "" || strings.EqualFold(levelOfDetail, imported)
+}
+
+func ibkrCashOperationType(transactionType string, amount float64) (pb.OperationType, bool) {
+	switch transactionType {
+	case "Deposits/Withdrawals", "Deposits & Withdrawals":
+		if amount < 0 {
+			return pb.OperationType_OPERATION_TYPE_PAY_OUT, true
+		}
+		return pb.OperationType_OPERATION_TYPE_PAY_IN, true
+	case "Dividends", "Payment In Lieu Of Dividends":
+		return pb.OperationType_OPERATION_TYPE_DIVIDEND, true
+	case "Withholding Tax":
+		if amount > 0 {
+			return pb.OperationType_OPERATION_TYPE_TAX_BACK, true
+		}
+		return pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND, true
+	case "Bond Interest Received", "Bond Interest Paid":
+		return pb.OperationType_OPERATION_TYPE_COUPON, true
+	case "Other Fees", "Broker Interest Paid", "Commission Adjustments":
+		return pb.OperationType_OPERATION_TYPE_COMMISSION, true
+	default:
+		return pb.OperationType_OPERATION_TYPE_UNSPECIFIED, false
+	}
+}
+
+var ibkrDateLayouts = []string{"20060102;150405", "20060102", "2006-01-02;15:04:05", "2006-01-02, 15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+func parseIBKRDate(value string) (time.Time, error) {
+	for _, layout := range ibkrDateLayouts {
+		if date, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse date %q", value)
+}
+
+func abs(quantity int32) int32 {
+	if quantity < 0 {
+		return -quantity
+	}
+	return quantity
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
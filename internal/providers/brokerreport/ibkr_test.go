@@ -0,0 +1,162 @@
+package brokerreport
+
+import (
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const flexQuery = `<?xml version="1.0" encoding="UTF-8"?>
+<FlexQueryResponse queryName="goinvest" type="AF">
+<FlexStatements count="1">
+<FlexStatement accountId="U1234567" fromDate="20210101" toDate="20211231">
+<AccountInformation accountId="U1234567" name="John Doe" currency="USD" />
+<Trades>
+<Trade accountId="U1234567" currency="USD" assetCategory="STK" symbol="AAPL" description="APPLE INC" isin="US0378331005" figi="BBG000B9XRY4" tradeID="101" transactionID="1001" dateTime="20210301;093000" tradeDate="20210301" quantity="10" tradePrice="120.5" proceeds="-1205" ibCommission="-1.05" buySell="BUY" levelOfDetail="EXECUTION" />
+<Trade accountId="U1234567" currency="USD" assetCategory="STK" symbol="AAPL" tradeID="101" dateTime="20210301;093000" quantity="10" buySell="BUY" levelOfDetail="ORDER" />
+<Trade accountId="U1234567" currency="RUB" assetCategory="CASH" symbol="USD.RUB" tradeID="102" transactionID="1002" dateTime="20210302;100000" quantity="100" tradePrice="74" proceeds="-7400" buySell="BUY" levelOfDetail="EXECUTION" />
+</Trades>
+<CashTransactions>
+<CashTransaction accountId="U1234567" currency="USD" assetCategory="STK" symbol="AAPL" figi="BBG000B9XRY4" dateTime="20210513;202000" amount="2.05" type="Dividends" transactionID="2001" />
+<CashTransaction accountId="U1234567" currency="USD" assetCategory="STK" symbol="AAPL" figi="BBG000B9XRY4" dateTime="20210513;202000" amount="-0.21" type="Withholding Tax" transactionID="2002" />
+<CashTransaction accountId="U1234567" currency="USD" dateTime="20210201" amount="5000" type="Deposits/Withdrawals" transactionID="2003" />
+<CashTransaction accountId="U1234567" currency="USD" dateTime="20210201" amount="0.01" type="Broker Interest Received" transactionID="2004" />
+</CashTransactions>
+<OpenPositions>
+<OpenPosition accountId="U1234567" currency="USD" assetCategory="STK" symbol="AAPL" description="APPLE INC" securityID="US0378331005" securityIDType="ISIN" figi="BBG000B9XRY4" position="40" markPrice="177.57" costBasisPrice="30.16" levelOfDetail="SUMMARY" />
+<OpenPosition accountId="U1234567" currency="USD" assetCategory="STK" symbol="AAPL" position="40" levelOfDetail="LOT" />
+</OpenPositions>
+<CashReport>
+<CashReportCurrency accountId="U1234567" currency="BASE_SUMMARY" endingCash="3790" levelOfDetail="Currency" />
+<CashReportCurrency accountId="U1234567" currency="USD" endingCash="3790" levelOfDetail="Currency" />
+</CashReport>
+<CorporateActions>
+<CorporateAction accountId="U1234567" currency="USD" assetCategory="STK" symbol="AAPL" figi="BBG000B9XRY4" dateTime="20210830;202500" type="FS" quantity="30" proceeds="0" actionID="3001" transactionID="3002" />
+</CorporateActions>
+</FlexStatement>
+</FlexStatements>
+</FlexQueryResponse>`
+
+func TestParseIBKRFlex(t *testing.T) {
+
+	statements, err := ParseIBKRFlex(strings.NewReader(flexQuery))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("unexpected statements %v", statements)
+	}
+	statement := statements[0]
+	if statement.Account.AccountID != "U1234567" || statement.Account.Name != "John Doe" {
+		t.Errorf("unexpected account %v", statement.Account)
+	}
+
+	types := make([]pb.OperationType, 0, len(statement.Operations))
+	for _, operation := range statement.Operations {
+		types = append(types, operation.OperationType)
+	}
+	expected := []pb.OperationType{
+		pb.OperationType_OPERATION_TYPE_BUY,
+		pb.OperationType_OPERATION_TYPE_DIVIDEND,
+		pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND,
+		pb.OperationType_OPERATION_TYPE_PAY_IN,
+		pb.OperationType_OPERATION_TYPE_SECURITY_IN,
+	}
+	if len(types) != len(expected) {
+		t.Fatalf("unexpected operations %v", types)
+	}
+	for i := range expected {
+		if types[i] != expected[i] {
+			t.Errorf("operation %d: expected %s, got %s", i, expected[i], types[i])
+		}
+	}
+
+	buy := statement.Operations[0]
+	if buy.ID != "1001" || buy.Figi != "BBG000B9XRY4" || buy.InstrumentType != "Stock" || buy.Quantity != 10 ||
+		buy.Payment != -1205 || buy.Commission != 1.05 || !buy.Date.Equal(time.Date(2021, time.March, 1, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected buy %v", buy)
+	}
+	if split := statement.Operations[4]; split.Quantity != 30 || split.ID != "3002" {
+		t.Errorf("unexpected split %v", split)
+	}
+
+	if len(statement.Positions) != 2 {
+		t.Fatalf("unexpected positions %v", statement.Positions)
+	}
+	stock, cash := statement.Positions[0], statement.Positions[1]
+	if stock.Isin != "US0378331005" || stock.Balance != 40 || stock.Price != 177.57 || stock.AveragePrice != 30.16 {
+		t.Errorf("unexpected stock position %v", stock)
+	}
+	if cash.InstrumentType != invest.InstrumentTypeCurrency || cash.Currency != "USD" || cash.Balance != 3790 {
+		t.Errorf("unexpected cash position %v", cash)
+	}
+}
+
+func TestParseIBKRFlexWithoutPositions(t *testing.T) {
+
+	const query = `<FlexQueryResponse><FlexStatements><FlexStatement accountId="U1">
+<Trades><Trade currency="USD" assetCategory="STK" symbol="MSFT" tradeID="1" tradeDate="20210105" quantity="-2" tradePrice="200" proceeds="400" buySell="SELL" /></Trades>
+</FlexStatement></FlexStatements></FlexQueryResponse>`
+
+	statements, err := ParseIBKRFlex(strings.NewReader(query))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statements[0].Positions != nil {
+		t.Errorf("positions must not be reported, got %v", statements[0].Positions)
+	}
+	sell := statements[0].Operations[0]
+	if sell.OperationType != pb.OperationType_OPERATION_TYPE_SELL || sell.Quantity != 2 || sell.Figi != "" || sell.Ticker != "MSFT" {
+		t.Errorf("unexpected sell %v", sell)
+	}
+
+	if _, err := ParseIBKRFlex(strings.NewReader(`<FlexStatementResponse><Status>Fail</Status></FlexStatementResponse>`)); err == nil {
+		t.Error("expected error for failed flex query")
+	}
+}
+
+func TestParseIBKRFlexLevelsOfDetail(t *testing.T) {
+
+	// summary rows duplicate detailed ones, reverse split removes old and adds new ISIN by the same action
+	const query = `<FlexQueryResponse><FlexStatements><FlexStatement accountId="U1">
+<CashTransactions>
+<CashTransaction currency="USD" symbol="AAPL" dateTime="20210513;202000" amount="2.05" type="Dividends" transactionID="1" levelOfDetail="DETAIL" />
+<CashTransaction currency="USD" symbol="AAPL" dateTime="20210513;202000" amount="2.05" type="Dividends" levelOfDetail="SUMMARY" />
+</CashTransactions>
+<CorporateActions>
+<CorporateAction currency="USD" assetCategory="STK" symbol="OLD" isin="US0000000001" dateTime="20210830;202500" type="RS" quantity="-100" actionID="7" transactionID="2" levelOfDetail="DETAIL" />
+<CorporateAction currency="USD" assetCategory="STK" symbol="NEW" isin="US0000000002" dateTime="20210830;202500" type="RS" quantity="10" actionID="7" transactionID="3" levelOfDetail="DETAIL" />
+<CorporateAction currency="USD" assetCategory="STK" symbol="NEW" dateTime="20210830;202500" type="RS" quantity="-90" actionID="7" levelOfDetail="SUMMARY" />
+</CorporateActions>
+</FlexStatement></FlexStatements></FlexQueryResponse>`
+
+	statements, err := ParseIBKRFlex(strings.NewReader(query))
+	if err != nil {
+		t.Fatal(err)
+	}
+	operations := statements[0].Operations
+	if len(operations) != 3 {
+		t.Fatalf("unexpected operations %v", operations)
+	}
+	out, in := operations[1], operations[2]
+	if out.OperationType != pb.OperationType_OPERATION_TYPE_SECURITY_OUT || out.Figi != "" || out.Isin != "US0000000001" || out.Quantity != 100 {
+		t.Errorf("unexpected removed securities %v", out)
+	}
+	if in.OperationType != pb.OperationType_OPERATION_TYPE_SECURITY_IN || in.Figi != "" || in.Isin != "US0000000002" || in.Ticker != "NEW" || in.Quantity != 10 ||
+		!in.Date.Equal(out.Date) {
+		t.Errorf("unexpected received securities %v", in)
+	}
+}
+
+func TestParseIBKRFlexFractionalQuantity(t *testing.T) {
+
+	const query = `<FlexQueryResponse><FlexStatements><FlexStatement accountId="U1">
+<Trades><Trade currency="USD" assetCategory="STK" symbol="MSFT" tradeID="1" tradeDate="20210105" quantity="0.5" tradePrice="200" proceeds="-100" buySell="BUY" /></Trades>
+</FlexStatement></FlexStatements></FlexQueryResponse>`
+
+	if _, err := ParseIBKRFlex(strings.NewReader(query)); err == nil || !strings.Contains(err.Error(), "fractional quantity 0.5") {
+		t.Errorf("expected fractional quantity error, got %v", err)
+	}
+}
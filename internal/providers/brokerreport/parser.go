@@ -0,0 +1,72 @@
+package brokerreport
+
+import (
+	"fmt"
+	"goinvest/internal/invest"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Statement is a parsed broker statement of a single account.
+type Statement struct {
+	Account invest.LedgerAccount
+	// Positions is a positions snapshot at the end of statement period,
+	// nil positions mean that statement does not report positions at all.
+	Positions  []invest.LedgerPosition
+	Operations []invest.LedgerOperation
+}
+
+// Parser parses broker statement of a specific format.
+type Parser interface {
+	Parse(r io.Reader) ([]Statement, error)
+}
+
+// ParserFunc is an adapter to use ordinary functions as parsers.
+type ParserFunc func(r io.Reader) ([]Statement, error)
+
+// Parse calls f(r).
+func (f ParserFunc) Parse(r io.Reader) ([]Statement, error) { return f(r) }
+
+var parsers = struct {
+	sync.RWMutex
+	byFormat map[string]Parser
+}{
+	byFormat: make(map[string]Parser),
+}
+
+// RegisterParser makes parser available for statements of format. It panics if format is registered twice.
+func RegisterParser(format string, parser Parser) {
+	parsers.Lock()
+	defer parsers.Unlock()
+
+	if parser == nil {
+		panic("brokerreport: register parser is nil")
+	}
+	if _, found := parsers.byFormat[format]; found {
+		panic(fmt.Sprintf("brokerreport: register parser called twice for format %s", format))
+	}
+	parsers.byFormat[format] = parser
+}
+
+// ParserByFormat returns parser registered for format.
+func ParserByFormat(format string) (Parser, bool) {
+	parsers.RLock()
+	defer parsers.RUnlock()
+
+	parser, found := parsers.byFormat[format]
+	return parser, found
+}
+
+// Formats returns sorted formats of registered parsers.
+func Formats() []string {
+	parsers.RLock()
+	defer parsers.RUnlock()
+
+	formats := make([]string, 0, len(parsers.byFormat))
+	for format := range parsers.byFormat {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
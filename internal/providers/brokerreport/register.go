@@ -0,0 +1,20 @@
+package brokerreport
+
+import (
+	"fmt"
+	"goinvest/internal/invest"
+)
+
+// ProviderID identifies imported accounts in ledger storage, it must not change once data is stored.
+const ProviderID invest.ProviderID = 2
+
+func init() {
+	invest.RegisterProvider("broker_report", ProviderID, newFromConfig)
+}
+
+func newFromConfig(_ invest.ProviderConfig, deps invest.ProviderDependencies) (invest.Provider, error) {
+	if deps.Storage == nil {
+		return nil, fmt.Errorf("provider %s: storage must be provided", ProviderID)
+	}
+	return NewBrokerReport(deps.Storage, deps.Logger)
+}
@@ -0,0 +1,256 @@
+// Package fake implements deterministic provider which serves accounts, portfolios, operations and quotes
+// from a YAML or JSON fixture, so the server and its tests run without broker credentials.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ghodss/yaml"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"time"
+)
+
+// Methods of provider which errors can be injected into.
+const (
+	MethodAccounts   = "accounts"
+	MethodPortfolio  = "portfolio"
+	MethodOperations = "operations"
+	MethodQuote      = "quote"
+)
+
+// Fixture is a scripted provider data. Accounts, portfolios, operations and quotes use protobuf JSON
+// mapping of corresponding messages. Errors map provider method to injected error, "not_found" and
+// "invalid_argument" are mapped to invest errors, any other value is returned as an error message.
+//
+//	latency: 50ms
+//	accounts:
+//	  - account: {accountId: "2000", accountType: TYPE_BROKER}
+//	    portfolio: {positions: [{figi: BBG000B9XRY4, ticker: AAPL, balance: 1}]}
+//	    operations: [{id: "1", operationType: OPERATION_TYPE_BUY, date: "2021-03-01T10:00:00Z"}]
+//	quotes: [{figi: BBG000B9XRY4, price: 150, currency: USD, lot: 1}]
+//	errors: {quote: not_found}
+type Fixture struct {
+	Latency  string            `json:"latency"`
+	Accounts []FixtureAccount  `json:"accounts"`
+	Quotes   []json.RawMessage `json:"quotes"`
+	Errors   map[string]string `json:"errors"`
+}
+
+// FixtureAccount is a scripted account among with its portfolio and operations.
+type FixtureAccount struct {
+	Account    json.RawMessage   `json:"account"`
+	Portfolio  json.RawMessage   `json:"portfolio"`
+	Operations []json.RawMessage `json:"operations"`
+}
+
+type account struct {
+	account    *pb.Account
+	portfolio  *pb.PortfolioResponse
+	operations []*pb.Operation
+}
+
+type providerFake struct {
+	latency  time.Duration
+	accounts []account
+	quotes   map[string]*pb.Quote
+	errors   map[string]error
+}
+
+// LoadFixture reads fixture from YAML or JSON file.
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fixture: %w", err)
+	}
+	return ParseFixture(data)
+}
+
+// ParseFixture parses fixture from YAML or JSON.
+func ParseFixture(data []byte) (*Fixture, error) {
+	var fixture Fixture
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("parse fixture: %w", err)
+	}
+	return &fixture, nil
+}
+
+// NewFake is a constructor-like function which constructs fake provider serving fixture.
+func NewFake(fixture *Fixture) (invest.Provider, error) {
+
+	if fixture == nil {
+		return nil, fmt.Errorf("provider %s: fixture must be provided", ProviderID)
+	}
+
+	p := &providerFake{
+		quotes: make(map[string]*pb.Quote, len(fixture.Quotes)),
+		errors: make(map[string]error, len(fixture.Errors)),
+	}
+
+	if fixture.Latency != "" {
+		latency, err := time.ParseDuration(fixture.Latency)
+		if err != nil {
+			return nil, fmt.Errorf("fixture latency: %w", err)
+		}
+		p.latency = latency
+	}
+
+	for i, fixtureAccount := range fixture.Accounts {
+		a := account{account: &pb.Account{}, portfolio: &pb.PortfolioResponse{}}
+		if err := unmarshal(fixtureAccount.Account, a.account); err != nil {
+			return nil, fmt.Errorf("fixture account %d: %w", i, err)
+		}
+		if err := unmarshal(fixtureAccount.Portfolio, a.portfolio); err != nil {
+			return nil, fmt.Errorf("fixture portfolio of account %s: %w", a.account.AccountId, err)
+		}
+		for _, raw := range fixtureAccount.Operations {
+			operation := &pb.Operation{}
+			if err := unmarshal(raw, operation); err != nil {
+				return nil, fmt.Errorf("fixture operation of account %s: %w", a.account.AccountId, err)
+			}
+			if _, err := time.Parse(time.RFC3339, operation.Date); err != nil {
+				return nil, fmt.Errorf("fixture operation %s date: %w", operation.Id, err)
+			}
+			a.operations = append(a.operations, operation)
+		}
+		p.accounts = append(p.accounts, a)
+	}
+
+	for _, raw := range fixture.Quotes {
+		quote := &pb.Quote{}
+		if err := unmarshal(raw, quote); err != nil {
+			return nil, fmt.Errorf("fixture quote: %w", err)
+		}
+		p.quotes[quote.Figi] = quote
+	}
+
+	for method, kind := range fixture.Errors {
+		switch method {
+		case MethodAccounts, MethodPortfolio, MethodOperations, MethodQuote:
+		default:
+			return nil, fmt.Errorf("fixture error of unknown method %q", method)
+		}
+		switch kind {
+		case "not_found":
+			p.errors[method] = fmt.Errorf("fake %s: %w", method, invest.ErrNotFound)
+		case "invalid_argument":
+			p.errors[method] = fmt.Errorf("fake %s: %w", method, invest.ErrInvalidArgument)
+		default:
+			p.errors[method] = errors.New(kind)
+		}
+	}
+
+	return p, nil
+}
+
+func (p *providerFake) Accounts(ctx context.Context, _ *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+
+	if err := p.call(ctx, MethodAccounts); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AccountsResponse{Accounts: make([]*pb.Account, 0, len(p.accounts))}
+	for _, a := range p.accounts {
+		resp.Accounts = append(resp.Accounts, proto.Clone(a.account).(*pb.Account))
+	}
+	return resp, nil
+}
+
+func (p *providerFake) Portfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+
+	if err := p.call(ctx, MethodPortfolio); err != nil {
+		return nil, err
+	}
+	if req.Account == nil {
+		return nil, fmt.Errorf("%w: account is nil", invest.ErrInvalidArgument)
+	}
+
+	a, err := p.account(req.Account.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(a.portfolio).(*pb.PortfolioResponse), nil
+}
+
+func (p *providerFake) Operations(ctx context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+
+	if err := p.call(ctx, MethodOperations); err != nil {
+		return nil, err
+	}
+	if req.Account == nil {
+		return nil, fmt.Errorf("%w: account is nil", invest.ErrInvalidArgument)
+	}
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse operations from date: %s", invest.ErrInvalidArgument, err)
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse operations to date: %s", invest.ErrInvalidArgument, err)
+	}
+
+	a, err := p.account(req.Account.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.OperationsResponse{}
+	for _, operation := range a.operations {
+		// dates are validated on fixture load
+		date, _ := time.Parse(time.RFC3339, operation.Date)
+		if date.Before(from) || !date.Before(to) || (req.Figi != "" && operation.Figi != req.Figi) {
+			continue
+		}
+		resp.Operations = append(resp.Operations, proto.Clone(operation).(*pb.Operation))
+	}
+	return resp, nil
+}
+
+func (p *providerFake) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+
+	if err := p.call(ctx, MethodQuote); err != nil {
+		return nil, err
+	}
+
+	quote, found := p.quotes[req.Figi]
+	if !found {
+		return nil, fmt.Errorf("quote of %s: %w", req.Figi, invest.ErrNotFound)
+	}
+	return &pb.QuoteResponse{Quote: proto.Clone(quote).(*pb.Quote)}, nil
+}
+
+// call simulates network call of method, waiting for latency and returning injected error.
+func (p *providerFake) call(ctx context.Context, method string) error {
+	if p.latency > 0 {
+		timer := time.NewTimer(p.latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return p.errors[method]
+}
+
+func (p *providerFake) account(accountID string) (account, error) {
+	for _, a := range p.accounts {
+		if a.account.AccountId == accountID {
+			return a, nil
+		}
+	}
+	return account{}, fmt.Errorf("fake account %s: %w", accountID, invest.ErrNotFound)
+}
+
+func unmarshal(raw json.RawMessage, message proto.Message) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	return protojson.Unmarshal(raw, message)
+}
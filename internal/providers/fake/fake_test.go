@@ -0,0 +1,92 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+
+	fixture, err := LoadFixture("testdata/fixture.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := NewFake(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	accounts, err := provider.Accounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts.Accounts) != 2 || accounts.Accounts[1].AccountType != pb.AccountType_TYPE_IIS {
+		t.Errorf("unexpected accounts %v", accounts.Accounts)
+	}
+
+	portfolio, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: accounts.Accounts[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(portfolio.Positions) != 2 || portfolio.Positions[0].AveragePositionPrice.Value != 120 || len(portfolio.Currencies) != 2 {
+		t.Errorf("unexpected portfolio %v", portfolio)
+	}
+
+	operations, err := provider.Operations(ctx, &pb.OperationsRequest{
+		Account: accounts.Accounts[0],
+		From:    "2021-01-11T00:00:00Z",
+		To:      "2021-01-12T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(operations.Operations) != 1 || operations.Operations[0].OperationType != pb.OperationType_OPERATION_TYPE_BUY {
+		t.Errorf("unexpected operations %v", operations.Operations)
+	}
+
+	if _, err := provider.Quote(ctx, &pb.QuoteRequest{Figi: "unknown"}); !errors.Is(err, invest.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if _, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: &pb.Account{AccountId: "unknown"}}); !errors.Is(err, invest.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestFakeInjections(t *testing.T) {
+
+	fixture, err := ParseFixture([]byte(`{"latency": "1s", "errors": {"accounts": "unavailable", "quote": "invalid_argument"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := NewFake(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := provider.Accounts(ctx, &pb.AccountsRequest{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	fixture.Latency = ""
+	provider, err = NewFake(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := provider.Accounts(context.Background(), &pb.AccountsRequest{}); err == nil || err.Error() != "unavailable" {
+		t.Errorf("expected injected error, got %v", err)
+	}
+	if _, err := provider.Quote(context.Background(), &pb.QuoteRequest{}); !errors.Is(err, invest.ErrInvalidArgument) {
+		t.Errorf("expected invalid argument error, got %v", err)
+	}
+
+	if _, err := NewFake(&Fixture{Errors: map[string]string{"order": "unavailable"}}); err == nil {
+		t.Error("expected error for unknown method")
+	}
+}
@@ -0,0 +1,32 @@
+package fake
+
+import (
+	"fmt"
+	"goinvest/internal/invest"
+)
+
+// ProviderID identifies fake provider, it is used for local development and tests only.
+const ProviderID invest.ProviderID = 4
+
+func init() {
+	invest.RegisterProvider("fake", ProviderID, newFromConfig)
+}
+
+// newFromConfig constructs fake provider from providers config,
+// options are "fixture" file path and "latency" which overrides fixture latency.
+func newFromConfig(conf invest.ProviderConfig, _ invest.ProviderDependencies) (invest.Provider, error) {
+
+	path := conf.Options["fixture"]
+	if path == "" {
+		return nil, fmt.Errorf("provider %s: fixture option is required", ProviderID)
+	}
+	fixture, err := LoadFixture(path)
+	if err != nil {
+		return nil, fmt.Errorf("provider %s: %w", ProviderID, err)
+	}
+	if latency := conf.Options["latency"]; latency != "" {
+		fixture.Latency = latency
+	}
+
+	return NewFake(fixture)
+}
@@ -0,0 +1,175 @@
+// Package ledger implements read side of providers without API, which accounts, positions and operations
+// are kept in invest.LedgerStorage, either imported from broker statements or entered manually.
+package ledger
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"math"
+	"time"
+)
+
+// Provider serves accounts, portfolios and operations of provider from ledger storage.
+type Provider struct {
+	ID      invest.ProviderID
+	Storage invest.LedgerStorage
+}
+
+// New is a constructor-like function which constructs ledger Provider of provider id.
+func New(id invest.ProviderID, storage invest.LedgerStorage) (*Provider, error) {
+	if storage == nil {
+		return nil, fmt.Errorf("provider %s: storage must be provided", id)
+	}
+	return &Provider{ID: id, Storage: storage}, nil
+}
+
+func (p *Provider) Accounts(ctx context.Context, _ *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+
+	accounts, err := p.Storage.LedgerAccounts(ctx, p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load ledger accounts: %w", err)
+	}
+
+	resp := &pb.AccountsResponse{Accounts: make([]*pb.Account, 0, len(accounts))}
+	for _, account := range accounts {
+		resp.Accounts = append(resp.Accounts, &pb.Account{
+			AccountId:   account.AccountID,
+			AccountType: account.AccountType,
+		})
+	}
+	return resp, nil
+}
+
+func (p *Provider) Portfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+
+	if req.Account == nil {
+		return nil, fmt.Errorf("%w: account is nil", invest.ErrInvalidArgument)
+	}
+
+	positions, err := p.Storage.LedgerPositions(ctx, p.ID, req.Account.AccountId)
+	if err != nil {
+		return nil, fmt.Errorf("load ledger positions: %w", err)
+	}
+
+	return Portfolio(positions), nil
+}
+
+func (p *Provider) Operations(ctx context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+
+	if req.Account == nil {
+		return nil, fmt.Errorf("%w: account is nil", invest.ErrInvalidArgument)
+	}
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse operations from date: %s", invest.ErrInvalidArgument, err)
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse operations to date: %s", invest.ErrInvalidArgument, err)
+	}
+
+	operations, err := p.Storage.LedgerOperations(ctx, p.ID, req.Account.AccountId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("load ledger operations: %w", err)
+	}
+
+	resp := &pb.OperationsResponse{Operations: make([]*pb.Operation, 0, len(operations))}
+	for _, operation := range operations {
+		if req.Figi != "" && operation.Figi != req.Figi {
+			continue
+		}
+		resp.Operations = append(resp.Operations, &pb.Operation{
+			Id:             operation.ID,
+			OperationType:  operation.OperationType,
+			Figi:           operation.Figi,
+			InstrumentType: operation.InstrumentType,
+			Date:           operation.Date.Format(time.RFC3339),
+			Quantity:       operation.Quantity,
+			Price:          operation.Price,
+			Payment:        operation.Payment,
+			Currency:       operation.Currency,
+			Commission:     &pb.Yield{Currency: operation.Currency, Value: operation.Commission},
+			Isin:           operation.Isin,
+			Ticker:         operation.Ticker,
+		})
+	}
+	return resp, nil
+}
+
+// Quote returns last known price of instrument held in any of provider accounts.
+func (p *Provider) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+
+	if req.Figi == "" {
+		return nil, fmt.Errorf("%w: figi is required", invest.ErrInvalidArgument)
+	}
+
+	accounts, err := p.Storage.LedgerAccounts(ctx, p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load ledger accounts: %w", err)
+	}
+
+	for _, account := range accounts {
+		positions, err := p.Storage.LedgerPositions(ctx, p.ID, account.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("load ledger positions: %w", err)
+		}
+		for _, position := range positions {
+			if position.Figi != req.Figi {
+				continue
+			}
+			return &pb.QuoteResponse{Quote: &pb.Quote{
+				Figi:     position.Figi,
+				Ticker:   position.Ticker,
+				Price:    price(position),
+				Currency: position.Currency,
+				Lot:      1,
+			}}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("quote of %s: %w", req.Figi, invest.ErrNotFound)
+}
+
+// Portfolio converts ledger positions to portfolio, currency positions are reported as currency balances.
+func Portfolio(positions []invest.LedgerPosition) *pb.PortfolioResponse {
+
+	resp := &pb.PortfolioResponse{}
+	for _, position := range positions {
+		if position.InstrumentType == invest.InstrumentTypeCurrency {
+			resp.Currencies = append(resp.Currencies, &pb.CurrencyBalance{
+				Currency: position.Currency,
+				Balance:  position.Balance,
+			})
+			continue
+		}
+		resp.Positions = append(resp.Positions, &pb.Position{
+			Figi:           position.Figi,
+			Ticker:         position.Ticker,
+			Isin:           position.Isin,
+			Name:           position.Name,
+			InstrumentType: position.InstrumentType,
+			Balance:        position.Balance,
+			Lots:           int32(math.Floor(position.Balance)),
+			AveragePositionPrice: &pb.Yield{
+				Currency: position.Currency,
+				Value:    position.AveragePrice,
+			},
+			ExpectedYield: &pb.Yield{
+				Currency: position.Currency,
+				Value:    (price(position) - position.AveragePrice) * position.Balance,
+			},
+		})
+	}
+	return resp
+}
+
+// price returns market price of position falling back to average price when market price is unknown.
+func price(position invest.LedgerPosition) float64 {
+	if position.Price != 0 {
+		return position.Price
+	}
+	return position.AveragePrice
+}
@@ -0,0 +1,242 @@
+// Package manual implements provider of user-defined accounts and positions, such as deposits, real estate
+// or crypto wallets, which are kept in invest.LedgerStorage and priced manually.
+package manual
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"goinvest/internal/providers/ledger"
+	"strings"
+)
+
+// NewManual is a constructor-like function which constructs manual provider.
+func NewManual(storage invest.LedgerStorage) (invest.Provider, error) {
+	return ledger.New(ProviderID, storage)
+}
+
+// Accounts returns manual accounts.
+func Accounts(ctx context.Context, storage invest.LedgerStorage, _ *pb.ManualAccountsRequest) (*pb.ManualAccountsResponse, error) {
+
+	accounts, err := storage.LedgerAccounts(ctx, ProviderID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ManualAccountsResponse{Accounts: make([]*pb.ManualAccount, 0, len(accounts))}
+	for _, account := range accounts {
+		resp.Accounts = append(resp.Accounts, &pb.ManualAccount{
+			AccountId:   account.AccountID,
+			AccountType: account.AccountType,
+			Name:        account.Name,
+		})
+	}
+	return resp, nil
+}
+
+// SaveAccount creates or renames manual account.
+func SaveAccount(ctx context.Context, storage invest.LedgerStorage, req *pb.SaveManualAccountRequest) (*pb.SaveManualAccountResponse, error) {
+
+	account := req.Account
+	if account == nil || strings.TrimSpace(account.AccountId) == "" {
+		return nil, fmt.Errorf("%w: account id is required", invest.ErrInvalidArgument)
+	}
+
+	err := storage.SaveLedgerAccount(ctx, invest.LedgerAccount{
+		Provider:    ProviderID,
+		AccountID:   account.AccountId,
+		AccountType: account.AccountType,
+		Name:        account.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SaveManualAccountResponse{Account: &pb.Account{
+		AccountId:   account.AccountId,
+		AccountType: account.AccountType,
+		Provider:    ProviderID.String(),
+	}}, nil
+}
+
+// DeleteAccount deletes manual account among with its positions.
+func DeleteAccount(ctx context.Context, storage invest.LedgerStorage, req *pb.DeleteManualAccountRequest) (*pb.DeleteManualAccountResponse, error) {
+
+	if err := accountExists(ctx, storage, req.AccountId); err != nil {
+		return nil, err
+	}
+	if err := storage.DeleteLedgerAccount(ctx, ProviderID, req.AccountId); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteManualAccountResponse{}, nil
+}
+
+// Positions returns positions of manual account.
+func Positions(ctx context.Context, storage invest.LedgerStorage, req *pb.ManualPositionsRequest) (*pb.ManualPositionsResponse, error) {
+
+	if err := accountExists(ctx, storage, req.AccountId); err != nil {
+		return nil, err
+	}
+	positions, err := storage.LedgerPositions(ctx, ProviderID, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ManualPositionsResponse{Positions: make([]*pb.ManualPosition, 0, len(positions))}
+	for _, p := range positions {
+		resp.Positions = append(resp.Positions, &pb.ManualPosition{
+			Figi:           p.Figi,
+			Ticker:         p.Ticker,
+			Isin:           p.Isin,
+			Name:           p.Name,
+			InstrumentType: p.InstrumentType,
+			Balance:        p.Balance,
+			AveragePrice:   p.AveragePrice,
+			Price:          p.Price,
+			Currency:       p.Currency,
+		})
+	}
+	return resp, nil
+}
+
+// SavePosition creates or replaces position of manual account, position is identified by the first known
+// of figi, isin and ticker. Cash is a position of "Currency" instrument type identified by its currency.
+func SavePosition(ctx context.Context, storage invest.LedgerStorage, req *pb.SaveManualPositionRequest) (*pb.SaveManualPositionResponse, error) {
+
+	p := req.Position
+	if p == nil {
+		return nil, fmt.Errorf("%w: position is required", invest.ErrInvalidArgument)
+	}
+	if p.Currency == "" {
+		return nil, fmt.Errorf("%w: position currency is required", invest.ErrInvalidArgument)
+	}
+	if p.Balance < 0 || p.AveragePrice < 0 || p.Price < 0 {
+		return nil, fmt.Errorf("%w: position balance and prices must not be negative", invest.ErrInvalidArgument)
+	}
+
+	position := invest.LedgerPosition{
+		Figi:           p.Figi,
+		Ticker:         p.Ticker,
+		Isin:           p.Isin,
+		Name:           p.Name,
+		InstrumentType: p.InstrumentType,
+		Balance:        p.Balance,
+		AveragePrice:   p.AveragePrice,
+		Price:          p.Price,
+		Currency:       strings.ToUpper(p.Currency),
+	}
+	if position.InstrumentType == invest.InstrumentTypeCurrency && position.Ticker == "" {
+		position.Ticker = position.Currency
+	}
+	if position.Key() == "" {
+		return nil, fmt.Errorf("%w: position figi, isin or ticker is required", invest.ErrInvalidArgument)
+	}
+
+	if err := accountExists(ctx, storage, req.AccountId); err != nil {
+		return nil, err
+	}
+	if err := storage.SaveLedgerPosition(ctx, ProviderID, req.AccountId, position); err != nil {
+		return nil, err
+	}
+
+	return &pb.SaveManualPositionResponse{}, nil
+}
+
+// DeletePosition deletes position of manual account by its key.
+func DeletePosition(ctx context.Context, storage invest.LedgerStorage, req *pb.DeleteManualPositionRequest) (*pb.DeleteManualPositionResponse, error) {
+
+	if req.Key == "" {
+		return nil, fmt.Errorf("%w: position key is required", invest.ErrInvalidArgument)
+	}
+	if err := accountExists(ctx, storage, req.AccountId); err != nil {
+		return nil, err
+	}
+
+	positions, err := storage.LedgerPositions(ctx, ProviderID, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := findPosition(positions, req.Key); !found {
+		return nil, fmt.Errorf("position %s of account %s: %w", req.Key, req.AccountId, invest.ErrNotFound)
+	}
+	if err := storage.DeleteLedgerPosition(ctx, ProviderID, req.AccountId, req.Key); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteManualPositionResponse{}, nil
+}
+
+// UpdatePrice sets market price of position by its key in the requested manual account,
+// or in every manual account holding it when account is not set.
+func UpdatePrice(ctx context.Context, storage invest.LedgerStorage, req *pb.UpdateManualPriceRequest) (*pb.UpdateManualPriceResponse, error) {
+
+	if req.Key == "" {
+		return nil, fmt.Errorf("%w: position key is required", invest.ErrInvalidArgument)
+	}
+	if req.Price < 0 {
+		return nil, fmt.Errorf("%w: price must not be negative", invest.ErrInvalidArgument)
+	}
+
+	accountIDs := []string{req.AccountId}
+	if req.AccountId == "" {
+		accounts, err := storage.LedgerAccounts(ctx, ProviderID)
+		if err != nil {
+			return nil, err
+		}
+		accountIDs = accountIDs[:0]
+		for _, account := range accounts {
+			accountIDs = append(accountIDs, account.AccountID)
+		}
+	}
+
+	resp := &pb.UpdateManualPriceResponse{}
+	for _, accountID := range accountIDs {
+		positions, err := storage.LedgerPositions(ctx, ProviderID, accountID)
+		if err != nil {
+			return nil, err
+		}
+		position, found := findPosition(positions, req.Key)
+		if !found {
+			continue
+		}
+		position.Price = req.Price
+		if err := storage.SaveLedgerPosition(ctx, ProviderID, accountID, position); err != nil {
+			return nil, err
+		}
+		resp.Updated++
+	}
+
+	if resp.Updated == 0 {
+		return nil, fmt.Errorf("position %s: %w", req.Key, invest.ErrNotFound)
+	}
+	return resp, nil
+}
+
+func accountExists(ctx context.Context, storage invest.LedgerStorage, accountID string) error {
+
+	if accountID == "" {
+		return fmt.Errorf("%w: account id is required", invest.ErrInvalidArgument)
+	}
+
+	accounts, err := storage.LedgerAccounts(ctx, ProviderID)
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		if account.AccountID == accountID {
+			return nil
+		}
+	}
+	return fmt.Errorf("manual account %s: %w", accountID, invest.ErrNotFound)
+}
+
+func findPosition(positions []invest.LedgerPosition, key string) (invest.LedgerPosition, bool) {
+	for _, position := range positions {
+		if position.Key() == key {
+			return position, true
+		}
+	}
+	return invest.LedgerPosition{}, false
+}
@@ -0,0 +1,141 @@
+package manual
+
+import (
+	"context"
+	"errors"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"testing"
+	"time"
+)
+
+// memoryStorage is an in-memory ledger storage of a single provider.
+type memoryStorage struct {
+	invest.LedgerStorage
+	accounts  map[string]invest.LedgerAccount
+	positions map[string]map[string]invest.LedgerPosition
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{
+		accounts:  make(map[string]invest.LedgerAccount),
+		positions: make(map[string]map[string]invest.LedgerPosition),
+	}
+}
+
+func (s *memoryStorage) LedgerAccounts(_ context.Context, _ invest.ProviderID) ([]invest.LedgerAccount, error) {
+	accounts := make([]invest.LedgerAccount, 0, len(s.accounts))
+	for _, account := range s.accounts {
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+func (s *memoryStorage) SaveLedgerAccount(_ context.Context, account invest.LedgerAccount) error {
+	s.accounts[account.AccountID] = account
+	return nil
+}
+
+func (s *memoryStorage) DeleteLedgerAccount(_ context.Context, _ invest.ProviderID, accountID string) error {
+	delete(s.accounts, accountID)
+	delete(s.positions, accountID)
+	return nil
+}
+
+func (s *memoryStorage) LedgerPositions(_ context.Context, _ invest.ProviderID, accountID string) ([]invest.LedgerPosition, error) {
+	positions := make([]invest.LedgerPosition, 0, len(s.positions[accountID]))
+	for _, position := range s.positions[accountID] {
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+func (s *memoryStorage) SaveLedgerPosition(_ context.Context, _ invest.ProviderID, accountID string, position invest.LedgerPosition) error {
+	if s.positions[accountID] == nil {
+		s.positions[accountID] = make(map[string]invest.LedgerPosition)
+	}
+	s.positions[accountID][position.Key()] = position
+	return nil
+}
+
+func (s *memoryStorage) DeleteLedgerPosition(_ context.Context, _ invest.ProviderID, accountID string, key string) error {
+	delete(s.positions[accountID], key)
+	return nil
+}
+
+func (s *memoryStorage) LedgerOperations(context.Context, invest.ProviderID, string, time.Time, time.Time) ([]invest.LedgerOperation, error) {
+	return nil, nil
+}
+
+func TestManualAssets(t *testing.T) {
+
+	ctx := context.Background()
+	storage := newMemoryStorage()
+
+	for _, id := range []string{"wallet", "cold"} {
+		if _, err := SaveAccount(ctx, storage, &pb.SaveManualAccountRequest{Account: &pb.ManualAccount{AccountId: id}}); err != nil {
+			t.Fatal(err)
+		}
+		_, err := SavePosition(ctx, storage, &pb.SaveManualPositionRequest{
+			AccountId: id,
+			Position:  &pb.ManualPosition{Ticker: "BTC", InstrumentType: "Crypto", Balance: 0.5, AveragePrice: 30000, Currency: "usd"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := SavePosition(ctx, storage, &pb.SaveManualPositionRequest{
+		AccountId: "wallet",
+		Position:  &pb.ManualPosition{InstrumentType: invest.InstrumentTypeCurrency, Balance: 100, Currency: "usd"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := UpdatePrice(ctx, storage, &pb.UpdateManualPriceRequest{Key: "BTC", Price: 40000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Updated != 2 {
+		t.Errorf("expected price of both accounts to be updated, got %d", updated.Updated)
+	}
+
+	provider, err := NewManual(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	portfolio, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: &pb.Account{AccountId: "wallet"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(portfolio.Positions) != 1 || len(portfolio.Currencies) != 1 || portfolio.Currencies[0].Balance != 100 {
+		t.Fatalf("unexpected portfolio %v", portfolio)
+	}
+	if value, currency := invest.PositionValue(portfolio.Positions[0]); value != 20000 || currency != "USD" {
+		t.Errorf("unexpected position value %v %s", value, currency)
+	}
+
+	_, err = DeletePosition(ctx, storage, &pb.DeleteManualPositionRequest{AccountId: "wallet", Key: "ETH"})
+	if !errors.Is(err, invest.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	_, err = SavePosition(ctx, storage, &pb.SaveManualPositionRequest{AccountId: "missing", Position: &pb.ManualPosition{Ticker: "X", Currency: "RUB"}})
+	if !errors.Is(err, invest.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	_, err = SavePosition(ctx, storage, &pb.SaveManualPositionRequest{AccountId: "wallet", Position: &pb.ManualPosition{Currency: "RUB"}})
+	if !errors.Is(err, invest.ErrInvalidArgument) {
+		t.Errorf("expected invalid argument error, got %v", err)
+	}
+
+	if _, err := DeleteAccount(ctx, storage, &pb.DeleteManualAccountRequest{AccountId: "cold"}); err != nil {
+		t.Fatal(err)
+	}
+	accounts, err := Accounts(ctx, storage, &pb.ManualAccountsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts.Accounts) != 1 || accounts.Accounts[0].AccountId != "wallet" {
+		t.Errorf("unexpected accounts %v", accounts.Accounts)
+	}
+}
@@ -0,0 +1,20 @@
+package manual
+
+import (
+	"fmt"
+	"goinvest/internal/invest"
+)
+
+// ProviderID identifies manual accounts in ledger storage, it must not change once data is stored.
+const ProviderID invest.ProviderID = 3
+
+func init() {
+	invest.RegisterProvider("manual", ProviderID, newFromConfig)
+}
+
+func newFromConfig(_ invest.ProviderConfig, deps invest.ProviderDependencies) (invest.Provider, error) {
+	if deps.Storage == nil {
+		return nil, fmt.Errorf("provider %s: storage must be provided", ProviderID)
+	}
+	return NewManual(deps.Storage)
+}
@@ -0,0 +1,68 @@
+package tinkoff
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/TinkoffCreditSystems/invest-openapi-go-sdk"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"time"
+)
+
+func (p providerTinkoff) Operations(ctx context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+
+	if req.Account == nil {
+		return nil, fmt.Errorf("%w: account is nil", invest.ErrInvalidArgument)
+	}
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse operations from date: %s", invest.ErrInvalidArgument, err)
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse operations to date: %s", invest.ErrInvalidArgument, err)
+	}
+
+	operationsResponse, err := p.client.Operations(ctx, req.Account.AccountId, from, to, req.Figi)
+	if err != nil {
+		return nil, fmt.Errorf("load operations provider err: %w", err)
+	}
+
+	return &pb.OperationsResponse{
+		Operations: resultFromProviderOperationsResponse(operationsResponse),
+	}, nil
+}
+
+// resultFromProviderOperationsResponse maps executed operations, declined and in-progress ones are skipped.
+func resultFromProviderOperationsResponse(operationsResponse []sdk.Operation) []*pb.Operation {
+	if len(operationsResponse) == 0 {
+		return nil
+	}
+	operations := make([]*pb.Operation, 0, len(operationsResponse))
+	for _, operation := range operationsResponse {
+		if operation.Status != sdk.OperationStatusDone {
+			continue
+		}
+		quantity := operation.QuantityExecuted
+		if quantity == 0 {
+			quantity = operation.Quantity
+		}
+		operations = append(operations, &pb.Operation{
+			Id:             operation.ID,
+			OperationType:  toPbOperationType(operation.OperationType),
+			Figi:           operation.FIGI,
+			InstrumentType: string(operation.InstrumentType),
+			Date:           operation.DateTime.Format(time.RFC3339),
+			Quantity:       int32(quantity),
+			Price:          operation.Price,
+			Payment:        operation.Payment,
+			Currency:       string(operation.Currency),
+			Commission: &pb.Yield{
+				Currency: string(operation.Commission.Currency),
+				Value:    operation.Commission.Value,
+			},
+		})
+	}
+	return operations
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	sdk "github.com/TinkoffCreditSystems/invest-openapi-go-sdk"
 	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
 	"sync"
 )
 
@@ -39,7 +40,7 @@ func (p providerTinkoff) Portfolio(ctx context.Context, req *pb.PortfolioRequest
 	defer releasePortfolioReq(r)
 
 	if req.Account == nil {
-		return nil, fmt.Errorf("account is nil")
+		return nil, fmt.Errorf("%w: account is nil", invest.ErrInvalidArgument)
 	}
 
 	//register, err := p.sandboxClient.Register(context.Background(), toSdkAccountType(req.Account.AccountType))
@@ -55,7 +56,8 @@ func (p providerTinkoff) Portfolio(ctx context.Context, req *pb.PortfolioRequest
 	positions := resultFromProviderPortfolioResponse(portfolioResponse)
 
 	return &pb.PortfolioResponse{
-		Positions: positions,
+		Positions:  positions,
+		Currencies: resultFromProviderCurrencies(portfolioResponse),
 	}, err
 }
 
@@ -93,6 +95,21 @@ func resultFromProviderPortfolioResponse(portfolioResponse sdk.Portfolio) []*pb.
 	return positions
 }
 
+func resultFromProviderCurrencies(portfolioResponse sdk.Portfolio) []*pb.CurrencyBalance {
+	if len(portfolioResponse.Currencies) == 0 {
+		return nil
+	}
+	currencies := make([]*pb.CurrencyBalance, 0, len(portfolioResponse.Currencies))
+	for _, currency := range portfolioResponse.Currencies {
+		currencies = append(currencies, &pb.CurrencyBalance{
+			Currency: string(currency.Currency),
+			Balance:  currency.Balance,
+			Blocked:  currency.Blocked,
+		})
+	}
+	return currencies
+}
+
 func resetPortfolio(portfolio *sdk.Portfolio) {
 	*portfolio = sdk.Portfolio{}
 }
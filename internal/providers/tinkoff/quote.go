@@ -0,0 +1,76 @@
+package tinkoff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sdk "github.com/TinkoffCreditSystems/invest-openapi-go-sdk"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// instrumentCacheTTL is expiration of instrument info, lot size and currency change rarely.
+const instrumentCacheTTL = 24 * time.Hour
+
+func (p providerTinkoff) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+
+	if req.Figi == "" {
+		return nil, fmt.Errorf("%w: figi is required", invest.ErrInvalidArgument)
+	}
+
+	instrument, err := p.instrument(ctx, req.Figi)
+	if err != nil {
+		return nil, err
+	}
+
+	orderbook, err := p.client.Orderbook(ctx, 1, req.Figi)
+	if err != nil {
+		return nil, fmt.Errorf("load orderbook provider err: %w", err)
+	}
+
+	price := orderbook.LastPrice
+	if price == 0 {
+		price = orderbook.ClosePrice
+	}
+
+	return &pb.QuoteResponse{
+		Quote: &pb.Quote{
+			Figi:     instrument.FIGI,
+			Ticker:   instrument.Ticker,
+			Price:    price,
+			Currency: string(instrument.Currency),
+			Lot:      int32(instrument.Lot),
+		},
+	}, nil
+}
+
+// instrument returns instrument info by figi, looking up the cache first.
+func (p providerTinkoff) instrument(ctx context.Context, figi string) (sdk.Instrument, error) {
+
+	key := "tinkoff:instrument:" + figi
+
+	var instrument sdk.Instrument
+	err := p.cache.Get(ctx, key, &instrument)
+	if err == nil {
+		return instrument, nil
+	}
+	if !errors.Is(err, invest.ErrCacheMiss) {
+		p.logger.Warn("problem while getting instrument from cache", zap.String("figi", figi), zap.Error(err))
+	}
+
+	instrument, err = p.client.InstrumentByFIGI(ctx, figi)
+	if err != nil {
+		if errors.Is(err, sdk.ErrNotFound) {
+			return sdk.Instrument{}, fmt.Errorf("instrument %s: %w", figi, invest.ErrNotFound)
+		}
+		return sdk.Instrument{}, fmt.Errorf("load instrument provider err: %w", err)
+	}
+
+	if err := p.cache.Set(ctx, key, instrument, instrumentCacheTTL); err != nil {
+		p.logger.Warn("problem while setting instrument to cache", zap.String("figi", figi), zap.Error(err))
+	}
+
+	return instrument, nil
+}
@@ -0,0 +1,28 @@
+package tinkoff
+
+import (
+	"goinvest/internal/invest"
+)
+
+// ProviderID identifies tinkoff accounts in storage, it must not change once data is stored.
+const ProviderID invest.ProviderID = 1
+
+func init() {
+	invest.RegisterProvider("tinkoff", ProviderID, newFromConfig)
+}
+
+// newFromConfig constructs Tinkoff from providers config,
+// credentials are "token" and "sandbox_token", options are "rps".
+func newFromConfig(conf invest.ProviderConfig, deps invest.ProviderDependencies) (invest.Provider, error) {
+	rps, err := conf.IntOption("rps", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &ProviderOptions{
+		Token:             conf.Credentials["token"],
+		SandboxToken:      conf.Credentials["sandbox_token"],
+		RequestsPerSecond: rps,
+	}
+	return NewTinkoff(options, deps.Cache, deps.Logger)
+}
@@ -0,0 +1,192 @@
+package tinkoff
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	sdk "github.com/TinkoffCreditSystems/invest-openapi-go-sdk"
+	"goinvest/internal/invest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// record rewrites cassettes with responses of real Tinkoff API, token is taken from TINKOFF_TOKEN env:
+//
+//	TINKOFF_TOKEN=... go test ./internal/providers/tinkoff -record
+var record = flag.Bool("record", false, "record Tinkoff API responses to testdata cassettes")
+
+// cassette is a recorded sequence of HTTP interactions with Tinkoff API.
+type cassette struct {
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		// URL is request path with query relative to API URL.
+		URL string `json:"url"`
+	} `json:"request"`
+	Response struct {
+		Status int             `json:"status"`
+		Body   json.RawMessage `json:"body"`
+	} `json:"response"`
+}
+
+// replayTransport serves requests from cassette in recorded order per request, or proxies them to Tinkoff API
+// recording responses. Authorization header is never recorded.
+type replayTransport struct {
+	t        *testing.T
+	mu       sync.Mutex
+	cassette cassette
+	used     []bool
+	token    string
+}
+
+func (rt *replayTransport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if *record {
+		rt.proxy(w, r)
+		return
+	}
+
+	for i, recorded := range rt.cassette.Interactions {
+		if rt.used[i] || recorded.Request.Method != r.Method || recorded.Request.URL != r.URL.RequestURI() {
+			continue
+		}
+		rt.used[i] = true
+
+		body := []byte(recorded.Response.Body)
+		var text string
+		if err := json.Unmarshal(body, &text); err == nil {
+			body = []byte(text)
+		}
+		w.WriteHeader(recorded.Response.Status)
+		_, _ = w.Write(body)
+		return
+	}
+
+	rt.t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+	http.Error(w, "request is not recorded", http.StatusTeapot)
+}
+
+func (rt *replayTransport) proxy(w http.ResponseWriter, r *http.Request) {
+
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, sdk.RestApiURL+r.URL.RequestURI(), r.Body)
+	if err != nil {
+		rt.t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+rt.token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		rt.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		rt.t.Fatal(err)
+	}
+
+	var recorded interaction
+	recorded.Request.Method = r.Method
+	recorded.Request.URL = r.URL.RequestURI()
+	recorded.Response.Status = resp.StatusCode
+	if json.Valid(body) {
+		recorded.Response.Body = body
+	} else {
+		recorded.Response.Body, _ = json.Marshal(string(body))
+	}
+	rt.cassette.Interactions = append(rt.cassette.Interactions, recorded)
+
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(body)
+}
+
+// newReplayClient returns Tinkoff client which requests are served from testdata/<name>.json cassette.
+// Every recorded interaction must be requested by the test.
+func newReplayClient(t *testing.T, name string) *sdk.RestClient {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".json")
+	rt := &replayTransport{t: t}
+
+	if *record {
+		rt.token = os.Getenv("TINKOFF_TOKEN")
+		if rt.token == "" {
+			t.Fatal("TINKOFF_TOKEN env is required to record cassettes")
+		}
+	} else {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &rt.cassette); err != nil {
+			t.Fatalf("cassette %s: %s", path, err)
+		}
+		rt.used = make([]bool, len(rt.cassette.Interactions))
+	}
+
+	server := httptest.NewServer(rt)
+	t.Cleanup(func() {
+		server.Close()
+
+		if *record {
+			data, err := json.MarshalIndent(rt.cassette, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+		for i, used := range rt.used {
+			if !used {
+				request := rt.cassette.Interactions[i].Request
+				t.Errorf("recorded request %s %s was not made", request.Method, request.URL)
+			}
+		}
+	})
+
+	return sdk.NewRestClientCustom("token", server.URL)
+}
+
+// memoryCache is an in-memory invest.Cache storing values as JSON like the real cache does.
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: make(map[string][]byte)}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string, ptrValue interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, found := c.values[key]
+	if !found {
+		return invest.ErrCacheMiss
+	}
+	return json.Unmarshal(data, ptrValue)
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, ptrValue interface{}, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, err := json.Marshal(ptrValue)
+	if err != nil {
+		return err
+	}
+	c.values[key] = data
+	return nil
+}
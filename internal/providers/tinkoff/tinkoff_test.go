@@ -0,0 +1,287 @@
+package tinkoff
+
+import (
+	"context"
+	"errors"
+	sdk "github.com/TinkoffCreditSystems/invest-openapi-go-sdk"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"testing"
+)
+
+const (
+	testAccountID = "2000000001"
+	testFigi      = "BBG000B9XRY4"
+)
+
+func newTestProvider(t *testing.T, cassette string) (invest.Provider, *memoryCache) {
+	t.Helper()
+
+	cache := newMemoryCache()
+	provider, err := NewTinkoff(&ProviderOptions{Client: newReplayClient(t, cassette)}, cache, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return provider, cache
+}
+
+func TestAccounts(t *testing.T) {
+
+	provider, _ := newTestProvider(t, "accounts")
+
+	resp, err := provider.Accounts(context.Background(), &pb.AccountsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Accounts) != 2 {
+		t.Fatalf("unexpected accounts %v", resp.Accounts)
+	}
+	if resp.Accounts[0].AccountId != testAccountID || resp.Accounts[0].AccountType != pb.AccountType_TYPE_BROKER {
+		t.Errorf("unexpected broker account %v", resp.Accounts[0])
+	}
+	if resp.Accounts[1].AccountId != "2000000002" || resp.Accounts[1].AccountType != pb.AccountType_TYPE_IIS {
+		t.Errorf("unexpected iis account %v", resp.Accounts[1])
+	}
+}
+
+func TestPortfolio(t *testing.T) {
+
+	provider, _ := newTestProvider(t, "portfolio")
+
+	resp, err := provider.Portfolio(context.Background(), &pb.PortfolioRequest{Account: &pb.Account{AccountId: testAccountID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Positions) != 2 {
+		t.Fatalf("unexpected positions %v", resp.Positions)
+	}
+
+	stock := resp.Positions[0]
+	if stock.Figi != testFigi || stock.Ticker != "AAPL" || stock.Isin != "US0378331005" || stock.InstrumentType != "Stock" ||
+		stock.Balance != 10 || stock.Blocked != 1 || stock.Lots != 10 || stock.Name != "Apple" {
+		t.Errorf("unexpected stock position %v", stock)
+	}
+	if stock.ExpectedYield.Value != 305.4 || stock.ExpectedYield.Currency != "USD" ||
+		stock.AveragePositionPrice.Value != 120.5 || stock.AveragePositionPrice.Currency != "USD" {
+		t.Errorf("unexpected stock yields %v / %v", stock.ExpectedYield, stock.AveragePositionPrice)
+	}
+	if stock.AveragePositionPriceNoNkd == nil || stock.AveragePositionPriceNoNkd.Value != 0 {
+		t.Errorf("unexpected stock price without nkd %v", stock.AveragePositionPriceNoNkd)
+	}
+
+	bond := resp.Positions[1]
+	if bond.InstrumentType != "Bond" || bond.ExpectedYield.Value != -12.5 ||
+		bond.AveragePositionPriceNoNkd.Value != 1001.5 || bond.AveragePositionPriceNoNkd.Currency != "RUB" {
+		t.Errorf("unexpected bond position %v", bond)
+	}
+
+	if len(resp.Currencies) != 2 {
+		t.Fatalf("unexpected currencies %v", resp.Currencies)
+	}
+	if resp.Currencies[0].Currency != "RUB" || resp.Currencies[0].Balance != 15000.25 {
+		t.Errorf("unexpected rouble balance %v", resp.Currencies[0])
+	}
+	if resp.Currencies[1].Currency != "USD" || resp.Currencies[1].Balance != 100 || resp.Currencies[1].Blocked != 20 {
+		t.Errorf("unexpected dollar balance %v", resp.Currencies[1])
+	}
+}
+
+func TestOperations(t *testing.T) {
+
+	provider, _ := newTestProvider(t, "operations")
+
+	resp, err := provider.Operations(context.Background(), &pb.OperationsRequest{
+		Account: &pb.Account{AccountId: testAccountID},
+		From:    "2021-01-01T00:00:00Z",
+		To:      "2021-02-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// declined operation 1002 is skipped
+	expected := []struct {
+		id            string
+		operationType pb.OperationType
+		payment       float64
+	}{
+		{"1001", pb.OperationType_OPERATION_TYPE_BUY, -1205},
+		{"1003", pb.OperationType_OPERATION_TYPE_DIVIDEND, 2.05},
+		{"1004", pb.OperationType_OPERATION_TYPE_PAY_IN, 50000},
+		{"1005", pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND, -0.21},
+	}
+	if len(resp.Operations) != len(expected) {
+		t.Fatalf("unexpected operations %v", resp.Operations)
+	}
+	for i, e := range expected {
+		operation := resp.Operations[i]
+		if operation.Id != e.id || operation.OperationType != e.operationType || operation.Payment != e.payment {
+			t.Errorf("operation %d: expected %s %v %v, got %v", i, e.id, e.operationType, e.payment, operation)
+		}
+	}
+
+	buy := resp.Operations[0]
+	if buy.Figi != testFigi || buy.InstrumentType != "Stock" || buy.Quantity != 10 || buy.Price != 120.5 ||
+		buy.Currency != "USD" || buy.Date != "2021-01-15T10:30:00+03:00" {
+		t.Errorf("unexpected buy operation %v", buy)
+	}
+	if buy.Commission.Value != -0.6 || buy.Commission.Currency != "USD" {
+		t.Errorf("unexpected buy commission %v", buy.Commission)
+	}
+}
+
+func TestQuote(t *testing.T) {
+
+	provider, cache := newTestProvider(t, "quote")
+	ctx := context.Background()
+
+	resp, err := provider.Quote(ctx, &pb.QuoteRequest{Figi: testFigi})
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote := resp.Quote
+	if quote.Figi != testFigi || quote.Ticker != "AAPL" || quote.Price != 150 || quote.Currency != "USD" || quote.Lot != 1 {
+		t.Errorf("unexpected quote %v", quote)
+	}
+
+	var instrument sdk.Instrument
+	if err := cache.Get(ctx, "tinkoff:instrument:"+testFigi, &instrument); err != nil || instrument.Ticker != "AAPL" {
+		t.Errorf("instrument is not cached: %v, %v", instrument, err)
+	}
+
+	// instrument is taken from cache, close price is used without last price
+	resp, err = provider.Quote(ctx, &pb.QuoteRequest{Figi: testFigi})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Quote.Price != 148.2 || resp.Quote.Lot != 1 {
+		t.Errorf("unexpected quote without last price %v", resp.Quote)
+	}
+}
+
+func TestErrors(t *testing.T) {
+
+	provider, _ := newTestProvider(t, "errors")
+	ctx := context.Background()
+
+	_, err := provider.Accounts(ctx, &pb.AccountsRequest{})
+	var tradingError sdk.TradingError
+	if !errors.As(err, &tradingError) || tradingError.Payload.Code != "INTERNAL_ERROR" {
+		t.Errorf("expected trading error, got %v", err)
+	}
+
+	_, err = provider.Portfolio(ctx, &pb.PortfolioRequest{Account: &pb.Account{AccountId: testAccountID}})
+	if err == nil || errors.Is(err, invest.ErrInvalidArgument) || errors.Is(err, invest.ErrNotFound) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+
+	_, err = provider.Quote(ctx, &pb.QuoteRequest{Figi: "UNKNOWN"})
+	if !errors.Is(err, invest.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	_, err = provider.Operations(ctx, &pb.OperationsRequest{
+		Account: &pb.Account{AccountId: testAccountID},
+		From:    "2021-01-01T00:00:00Z",
+		To:      "2021-02-01T00:00:00Z",
+	})
+	if err == nil {
+		t.Error("expected bad gateway error")
+	}
+}
+
+func TestInvalidArguments(t *testing.T) {
+
+	// client has no reachable API, requests must be rejected before it is called
+	provider, err := NewTinkoff(&ProviderOptions{Client: sdk.NewRestClientCustom("token", "http://127.0.0.1:0")},
+		newMemoryCache(), zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"portfolio without account", func() error {
+			_, err := provider.Portfolio(ctx, &pb.PortfolioRequest{})
+			return err
+		}},
+		{"operations without account", func() error {
+			_, err := provider.Operations(ctx, &pb.OperationsRequest{From: "2021-01-01T00:00:00Z", To: "2021-02-01T00:00:00Z"})
+			return err
+		}},
+		{"operations with bad from", func() error {
+			_, err := provider.Operations(ctx, &pb.OperationsRequest{Account: &pb.Account{}, From: "2021-01-01", To: "2021-02-01T00:00:00Z"})
+			return err
+		}},
+		{"operations with bad to", func() error {
+			_, err := provider.Operations(ctx, &pb.OperationsRequest{Account: &pb.Account{}, From: "2021-01-01T00:00:00Z"})
+			return err
+		}},
+		{"quote without figi", func() error {
+			_, err := provider.Quote(ctx, &pb.QuoteRequest{})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		if err := tt.call(); !errors.Is(err, invest.ErrInvalidArgument) {
+			t.Errorf("%s: expected invalid argument, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestAccountTypes(t *testing.T) {
+
+	tests := []struct {
+		sdkType sdk.AccountType
+		pbType  pb.AccountType
+	}{
+		{sdk.AccountTinkoff, pb.AccountType_TYPE_BROKER},
+		{sdk.AccountTinkoffIIS, pb.AccountType_TYPE_IIS},
+		{sdk.DefaultAccount, pb.AccountType_TYPE_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		if got := toPbAccountType(tt.sdkType); got != tt.pbType {
+			t.Errorf("toPbAccountType(%q): expected %v, got %v", tt.sdkType, tt.pbType, got)
+		}
+		if got := toSdkAccountType(tt.pbType); got != tt.sdkType {
+			t.Errorf("toSdkAccountType(%v): expected %q, got %q", tt.pbType, tt.sdkType, got)
+		}
+	}
+}
+
+func TestOperationTypes(t *testing.T) {
+
+	tests := map[sdk.OperationType]pb.OperationType{
+		sdk.BUY:                           pb.OperationType_OPERATION_TYPE_BUY,
+		sdk.OperationTypeBuyCard:          pb.OperationType_OPERATION_TYPE_BUY,
+		sdk.SELL:                          pb.OperationType_OPERATION_TYPE_SELL,
+		sdk.OperationTypePayIn:            pb.OperationType_OPERATION_TYPE_PAY_IN,
+		sdk.OperationTypePayOut:           pb.OperationType_OPERATION_TYPE_PAY_OUT,
+		sdk.OperationTypeDividend:         pb.OperationType_OPERATION_TYPE_DIVIDEND,
+		sdk.OperationTypeCoupon:           pb.OperationType_OPERATION_TYPE_COUPON,
+		sdk.OperationTypePartRepayment:    pb.OperationType_OPERATION_TYPE_REPAYMENT,
+		sdk.OperationTypeBrokerCommission: pb.OperationType_OPERATION_TYPE_COMMISSION,
+		sdk.OperationTypeMarginCommission: pb.OperationType_OPERATION_TYPE_COMMISSION,
+		sdk.OperationTypeTaxLucre:         pb.OperationType_OPERATION_TYPE_TAX,
+		sdk.OperationTypeTaxDividend:      pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND,
+		sdk.OperationTypeTaxCoupon:        pb.OperationType_OPERATION_TYPE_TAX_COUPON,
+		sdk.OperationTypeTaxBack:          pb.OperationType_OPERATION_TYPE_TAX_BACK,
+		sdk.OperationTypeSecurityIn:       pb.OperationType_OPERATION_TYPE_SECURITY_IN,
+		sdk.OperationTypeSecurityOut:      pb.OperationType_OPERATION_TYPE_SECURITY_OUT,
+		sdk.OperationType("UnknownType"):  pb.OperationType_OPERATION_TYPE_UNSPECIFIED,
+	}
+
+	for sdkType, pbType := range tests {
+		if got := toPbOperationType(sdkType); got != pbType {
+			t.Errorf("toPbOperationType(%q): expected %v, got %v", sdkType, pbType, got)
+		}
+	}
+}
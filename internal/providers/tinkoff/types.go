@@ -26,3 +26,39 @@ func toPbAccountType(sdkType sdk.AccountType) pb.AccountType {
 		return pb.AccountType_TYPE_UNSPECIFIED
 	}
 }
+
+func toPbOperationType(sdkType sdk.OperationType) pb.OperationType {
+	switch sdkType {
+	case sdk.BUY, sdk.OperationTypeBuyCard:
+		return pb.OperationType_OPERATION_TYPE_BUY
+	case sdk.SELL:
+		return pb.OperationType_OPERATION_TYPE_SELL
+	case sdk.OperationTypePayIn:
+		return pb.OperationType_OPERATION_TYPE_PAY_IN
+	case sdk.OperationTypePayOut:
+		return pb.OperationType_OPERATION_TYPE_PAY_OUT
+	case sdk.OperationTypeDividend:
+		return pb.OperationType_OPERATION_TYPE_DIVIDEND
+	case sdk.OperationTypeCoupon:
+		return pb.OperationType_OPERATION_TYPE_COUPON
+	case sdk.OperationTypeRepayment, sdk.OperationTypePartRepayment:
+		return pb.OperationType_OPERATION_TYPE_REPAYMENT
+	case sdk.OperationTypeBrokerCommission, sdk.OperationTypeExchangeCommission, sdk.OperationTypeServiceCommission,
+		sdk.OperationTypeMarginCommission, sdk.OperationTypeOtherCommission:
+		return pb.OperationType_OPERATION_TYPE_COMMISSION
+	case sdk.OperationTypeTax, sdk.OperationTypeTaxLucre:
+		return pb.OperationType_OPERATION_TYPE_TAX
+	case sdk.OperationTypeTaxDividend:
+		return pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND
+	case sdk.OperationTypeTaxCoupon:
+		return pb.OperationType_OPERATION_TYPE_TAX_COUPON
+	case sdk.OperationTypeTaxBack:
+		return pb.OperationType_OPERATION_TYPE_TAX_BACK
+	case sdk.OperationTypeSecurityIn:
+		return pb.OperationType_OPERATION_TYPE_SECURITY_IN
+	case sdk.OperationTypeSecurityOut:
+		return pb.OperationType_OPERATION_TYPE_SECURITY_OUT
+	default:
+		return pb.OperationType_OPERATION_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,290 @@
+package rebalance
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"math"
+	"sort"
+)
+
+const (
+	// defaultTolerance is used when request does not specify tolerance band.
+	defaultTolerance = 0.01
+	// weightEpsilon allows rounding errors in sum of target weights.
+	weightEpsilon = 1e-6
+)
+
+// SetTargetWeights validates and stores target model portfolio of account.
+func SetTargetWeights(ctx context.Context, storage invest.TargetStorage, req *pb.SetTargetWeightsRequest) (*pb.SetTargetWeightsResponse, error) {
+
+	if req.Account == nil || req.Account.AccountId == "" {
+		return nil, fmt.Errorf("%w: account is required", invest.ErrInvalidArgument)
+	}
+
+	var (
+		sum     float64
+		seen    = make(map[string]bool, len(req.Weights))
+		weights = make([]invest.TargetWeight, 0, len(req.Weights))
+	)
+	for _, w := range req.Weights {
+		if w.Figi == "" {
+			return nil, fmt.Errorf("%w: target figi is required", invest.ErrInvalidArgument)
+		}
+		if seen[w.Figi] {
+			return nil, fmt.Errorf("%w: duplicated target for figi %s", invest.ErrInvalidArgument, w.Figi)
+		}
+		if w.Weight < 0 || w.Weight > 1 {
+			return nil, fmt.Errorf("%w: target weight of %s must be between 0 and 1", invest.ErrInvalidArgument, w.Figi)
+		}
+		seen[w.Figi] = true
+		sum += w.Weight
+		weights = append(weights, invest.TargetWeight{Figi: w.Figi, Weight: w.Weight})
+	}
+	if sum > 1+weightEpsilon {
+		return nil, fmt.Errorf("%w: sum of target weights %.4f exceeds 1", invest.ErrInvalidArgument, sum)
+	}
+
+	if err := storage.SaveTargetWeights(ctx, req.Account.Provider, req.Account.AccountId, weights); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetTargetWeightsResponse{}, nil
+}
+
+// TargetWeights returns stored target model portfolio of account.
+func TargetWeights(ctx context.Context, storage invest.TargetStorage, req *pb.TargetWeightsRequest) (*pb.TargetWeightsResponse, error) {
+
+	if req.Account == nil || req.Account.AccountId == "" {
+		return nil, fmt.Errorf("%w: account is required", invest.ErrInvalidArgument)
+	}
+
+	weights, err := storage.TargetWeights(ctx, req.Account.Provider, req.Account.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.TargetWeightsResponse{Weights: make([]*pb.TargetWeight, 0, len(weights))}
+	for _, w := range weights {
+		resp.Weights = append(resp.Weights, &pb.TargetWeight{Figi: w.Figi, Weight: w.Weight})
+	}
+	return resp, nil
+}
+
+// Rebalance loads account portfolio and its targets and returns dry-run rebalancing plan.
+// Prices and lot sizes of held instruments are taken from portfolio, other targets are quoted by provider.
+func Rebalance(ctx context.Context, provider invest.Provider, storage invest.TargetStorage, req *pb.RebalanceRequest) (*pb.RebalanceResponse, error) {
+
+	if req.Account == nil || req.Account.AccountId == "" {
+		return nil, fmt.Errorf("%w: account is required", invest.ErrInvalidArgument)
+	}
+
+	targets, err := storage.TargetWeights(ctx, req.Account.Provider, req.Account.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("target weights of account %s: %w", req.Account.AccountId, invest.ErrNotFound)
+	}
+
+	portfolio, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: req.Account})
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := make(map[string]*pb.Quote, len(targets))
+	for _, position := range portfolio.Positions {
+		price, currency := invest.PositionPrice(position)
+		lot := int32(1)
+		if position.Lots > 0 {
+			lot = int32(math.Round(position.Balance / float64(position.Lots)))
+		}
+		quotes[position.Figi] = &pb.Quote{
+			Figi:     position.Figi,
+			Ticker:   position.Ticker,
+			Price:    price,
+			Currency: currency,
+			Lot:      lot,
+		}
+	}
+	for _, target := range targets {
+		if _, found := quotes[target.Figi]; found {
+			continue
+		}
+		quote, err := provider.Quote(ctx, &pb.QuoteRequest{Figi: target.Figi})
+		if err != nil {
+			return nil, fmt.Errorf("quote %s: %w", target.Figi, err)
+		}
+		quotes[target.Figi] = quote.Quote
+	}
+
+	tolerance := req.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultTolerance
+	}
+
+	return Plan(portfolio, targets, quotes, tolerance, invest.NewRates(req.CurrencyRates))
+}
+
+// candidate is an instrument which weight is out of tolerance band.
+type candidate struct {
+	quote     *pb.Quote
+	lotPrice  float64 // lot price in roubles
+	value     float64 // current value in roubles
+	heldLots  int32
+	current   float64
+	target    float64
+	deviation float64
+}
+
+// Plan proposes the minimal list of orders moving every instrument which weight deviates from target
+// more than tolerance to the nearest whole number of lots. Sells are planned first, buys are limited
+// by available cash, the most underweight instruments are bought first. Cash in all currencies is treated
+// as fungible at given rates.
+func Plan(portfolio *pb.PortfolioResponse, targets []invest.TargetWeight, quotes map[string]*pb.Quote,
+	tolerance float64, rates invest.Rates) (*pb.RebalanceResponse, error) {
+
+	var (
+		total, cash float64
+		values      = make(map[string]float64)
+		balances    = make(map[string]float64)
+		weights     = make(map[string]float64, len(targets))
+	)
+
+	for _, position := range portfolio.Positions {
+		// currency positions are accounted by portfolio currencies
+		if position.InstrumentType == invest.InstrumentTypeCurrency {
+			continue
+		}
+		value, currency := invest.PositionValue(position)
+		value, err := rates.ToRUB(value, currency)
+		if err != nil {
+			return nil, err
+		}
+		values[position.Figi] += value
+		balances[position.Figi] += position.Balance
+		total += value
+	}
+	for _, balance := range portfolio.Currencies {
+		value, err := rates.ToRUB(balance.Balance-balance.Blocked, balance.Currency)
+		if err != nil {
+			return nil, err
+		}
+		cash += value
+	}
+	total += cash
+	if total <= 0 {
+		return nil, fmt.Errorf("%w: portfolio is empty", invest.ErrInvalidArgument)
+	}
+
+	for _, target := range targets {
+		weights[target.Figi] = target.Weight
+	}
+
+	figis := make([]string, 0, len(values)+len(weights))
+	for figi := range values {
+		figis = append(figis, figi)
+	}
+	for figi := range weights {
+		if _, found := values[figi]; !found {
+			figis = append(figis, figi)
+		}
+	}
+	sort.Strings(figis)
+
+	var sells, buys []candidate
+	for _, figi := range figis {
+		c := candidate{
+			value:   values[figi],
+			current: values[figi] / total,
+			target:  weights[figi],
+		}
+		c.deviation = c.current - c.target
+		if math.Abs(c.deviation) <= tolerance {
+			continue
+		}
+
+		c.quote = quotes[figi]
+		if c.quote == nil || c.quote.Price <= 0 {
+			return nil, fmt.Errorf("%w: price of %s is unknown", invest.ErrInvalidArgument, figi)
+		}
+		lot := c.quote.Lot
+		if lot <= 0 {
+			lot = 1
+		}
+		lotPrice, err := rates.ToRUB(c.quote.Price*float64(lot), c.quote.Currency)
+		if err != nil {
+			return nil, err
+		}
+		c.lotPrice = lotPrice
+		c.heldLots = int32(balances[figi] / float64(lot))
+
+		if c.deviation > 0 {
+			sells = append(sells, c)
+		} else {
+			buys = append(buys, c)
+		}
+	}
+
+	// the most deviated instruments go first
+	sort.SliceStable(sells, func(i, j int) bool { return sells[i].deviation > sells[j].deviation })
+	sort.SliceStable(buys, func(i, j int) bool { return buys[i].deviation < buys[j].deviation })
+
+	resp := &pb.RebalanceResponse{
+		Total:      total,
+		CashBefore: cash,
+	}
+
+	for _, c := range sells {
+		lots := int32(math.Round((c.value - c.target*total) / c.lotPrice))
+		if c.target == 0 || lots > c.heldLots {
+			lots = c.heldLots
+		}
+		if lots <= 0 {
+			continue
+		}
+		cash += float64(lots) * c.lotPrice
+		resp.Orders = append(resp.Orders, order(c, pb.OperationType_OPERATION_TYPE_SELL, lots, total))
+	}
+
+	for _, c := range buys {
+		lots := int32(math.Round((c.target*total - c.value) / c.lotPrice))
+		if affordable := int32(math.Floor(cash / c.lotPrice)); lots > affordable {
+			lots = affordable
+		}
+		if lots <= 0 {
+			continue
+		}
+		cash -= float64(lots) * c.lotPrice
+		resp.Orders = append(resp.Orders, order(c, pb.OperationType_OPERATION_TYPE_BUY, lots, total))
+	}
+
+	resp.CashAfter = cash
+	return resp, nil
+}
+
+func order(c candidate, operationType pb.OperationType, lots int32, total float64) *pb.RebalanceOrder {
+	lot := c.quote.Lot
+	if lot <= 0 {
+		lot = 1
+	}
+	amount := float64(lots) * c.lotPrice
+	planned := c.value + amount
+	if operationType == pb.OperationType_OPERATION_TYPE_SELL {
+		planned = c.value - amount
+	}
+	return &pb.RebalanceOrder{
+		Figi:          c.quote.Figi,
+		Ticker:        c.quote.Ticker,
+		OperationType: operationType,
+		Lots:          lots,
+		Quantity:      lots * lot,
+		Price:         c.quote.Price,
+		Currency:      c.quote.Currency,
+		Amount:        amount,
+		CurrentWeight: c.current,
+		TargetWeight:  c.target,
+		PlannedWeight: planned / total,
+	}
+}
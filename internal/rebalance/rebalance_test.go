@@ -0,0 +1,82 @@
+package rebalance
+
+import (
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"testing"
+)
+
+func position(figi string, balance float64, lots int32, price float64) *pb.Position {
+	return &pb.Position{
+		Figi:                 figi,
+		Ticker:               figi,
+		InstrumentType:       "Stock",
+		Balance:              balance,
+		Lots:                 lots,
+		AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: price},
+	}
+}
+
+func TestPlan(t *testing.T) {
+
+	// total is 10000: A 6000, B 2000, cash 2000
+	portfolio := &pb.PortfolioResponse{
+		Positions: []*pb.Position{
+			position("A", 60, 6, 100),
+			position("B", 20, 20, 100),
+		},
+		Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 2000}},
+	}
+	targets := []invest.TargetWeight{
+		{Figi: "A", Weight: 0.4},
+		{Figi: "B", Weight: 0.205},
+		{Figi: "C", Weight: 0.3},
+	}
+	quotes := map[string]*pb.Quote{
+		"A": {Figi: "A", Ticker: "A", Price: 100, Currency: "RUB", Lot: 10},
+		"B": {Figi: "B", Ticker: "B", Price: 100, Currency: "RUB", Lot: 1},
+		"C": {Figi: "C", Ticker: "C", Price: 50, Currency: "USD", Lot: 1},
+	}
+
+	plan, err := Plan(portfolio, targets, quotes, 0.01, invest.Rates{"USD": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Total != 10000 || plan.CashBefore != 2000 {
+		t.Fatalf("unexpected totals %v / %v", plan.Total, plan.CashBefore)
+	}
+
+	// B is within tolerance, A is sold by 2 lots, C is bought for 3000 roubles.
+	if len(plan.Orders) != 2 {
+		t.Fatalf("unexpected orders %v", plan.Orders)
+	}
+
+	sell, buy := plan.Orders[0], plan.Orders[1]
+	if sell.Figi != "A" || sell.OperationType != pb.OperationType_OPERATION_TYPE_SELL || sell.Lots != 2 || sell.Quantity != 20 {
+		t.Errorf("unexpected sell order %v", sell)
+	}
+	if buy.Figi != "C" || buy.OperationType != pb.OperationType_OPERATION_TYPE_BUY || buy.Lots != 30 || buy.Amount != 3000 {
+		t.Errorf("unexpected buy order %v", buy)
+	}
+	if plan.CashAfter != 1000 {
+		t.Errorf("unexpected cash after %v", plan.CashAfter)
+	}
+}
+
+func TestPlanLimitedByCash(t *testing.T) {
+
+	portfolio := &pb.PortfolioResponse{
+		Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 1000}},
+	}
+	targets := []invest.TargetWeight{{Figi: "A", Weight: 1}}
+	quotes := map[string]*pb.Quote{"A": {Figi: "A", Price: 300, Currency: "RUB", Lot: 1}}
+
+	plan, err := Plan(portfolio, targets, quotes, 0.01, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Orders) != 1 || plan.Orders[0].Lots != 3 || plan.CashAfter != 100 {
+		t.Errorf("unexpected plan %v", plan)
+	}
+}
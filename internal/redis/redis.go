@@ -103,3 +103,11 @@ func (c *Cache) Set(ctx context.Context, key string, ptrValue interface{}, expir
 
 	return nil
 }
+
+// Ping checks connection to Redis.
+func (c *Cache) Ping(ctx context.Context) error {
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("problem while pinging cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goinvest/internal/invest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TTLCache overrides expiration of cached values by key prefix, e.g. "tinkoff:instrument:",
+// the longest matching prefix wins. Prefixes are case-insensitive, as config keys are.
+type TTLCache struct {
+	cache invest.Cache
+
+	mu   sync.RWMutex
+	ttls map[string]time.Duration
+}
+
+// NewTTLCache wraps cache to override expiration of its values by config.
+func NewTTLCache(cache invest.Cache, ttls map[string]time.Duration) (*TTLCache, error) {
+
+	if cache == nil {
+		return nil, errors.New("cache provided to ttl cache is nil")
+	}
+	c := &TTLCache{cache: cache}
+	if err := c.SetTTLs(ttls); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SetTTLs replaces expiration overrides, values set earlier keep their expiration.
+func (c *TTLCache) SetTTLs(ttls map[string]time.Duration) error {
+
+	normalized := make(map[string]time.Duration, len(ttls))
+	for prefix, ttl := range ttls {
+		if ttl <= 0 {
+			return fmt.Errorf("ttl %s of cache keys %q must be positive", ttl, prefix)
+		}
+		normalized[strings.ToLower(prefix)] = ttl
+	}
+	c.mu.Lock()
+	c.ttls = normalized
+	c.mu.Unlock()
+	return nil
+}
+
+// TTL returns expiration of the key, it is the expiration requested by caller unless it is overridden.
+func (c *TTLCache) TTL(key string, expires time.Duration) time.Duration {
+
+	key = strings.ToLower(key)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	matched := -1
+	for prefix, ttl := range c.ttls {
+		if len(prefix) > matched && strings.HasPrefix(key, prefix) {
+			matched, expires = len(prefix), ttl
+		}
+	}
+	return expires
+}
+
+func (c *TTLCache) Get(ctx context.Context, key string, ptrValue interface{}) error {
+	return c.cache.Get(ctx, key, ptrValue)
+}
+
+func (c *TTLCache) Set(ctx context.Context, key string, ptrValue interface{}, expires time.Duration) error {
+	return c.cache.Set(ctx, key, ptrValue, c.TTL(key, expires))
+}
@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"goinvest/internal/invest"
+	"testing"
+	"time"
+)
+
+type recordingCache struct {
+	invest.Cache
+	expires map[string]time.Duration
+}
+
+func (c *recordingCache) Set(_ context.Context, key string, _ interface{}, expires time.Duration) error {
+	c.expires[key] = expires
+	return nil
+}
+
+func TestTTLCache(t *testing.T) {
+
+	cache := &recordingCache{expires: map[string]time.Duration{}}
+	ttlCache, err := NewTTLCache(cache, map[string]time.Duration{
+		"tinkoff:":            time.Hour,
+		"Tinkoff:Instrument:": 12 * time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set := func(key string, expires time.Duration) time.Duration {
+		if err := ttlCache.Set(context.Background(), key, "value", expires); err != nil {
+			t.Fatal(err)
+		}
+		return cache.expires[key]
+	}
+	tests := []struct {
+		key           string
+		expires, want time.Duration
+	}{
+		{"tinkoff:instrument:BBG000B9XRY4", 24 * time.Hour, 12 * time.Hour},
+		{"tinkoff:orderbook:BBG000B9XRY4", time.Minute, time.Hour},
+		{"manual:price:cash", time.Minute, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := set(tt.key, tt.expires); got != tt.want {
+			t.Errorf("expiration of %s = %s, want %s", tt.key, got, tt.want)
+		}
+	}
+
+	// overrides are replaced at runtime
+	if err := ttlCache.SetTTLs(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := set("tinkoff:instrument:BBG000B9XRY4", 24*time.Hour); got != 24*time.Hour {
+		t.Errorf("expiration without overrides = %s", got)
+	}
+	if err := ttlCache.SetTTLs(map[string]time.Duration{"tinkoff:": 0}); err == nil {
+		t.Error("zero ttl is accepted")
+	}
+}
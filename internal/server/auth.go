@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+// healthService is gRPC health checking service, it is called by probes without credentials.
+const healthService = "/grpc.health.v1.Health/"
+
+// APIKeys authorizes gRPC and HTTP requests with bearer tokens, requests are not checked when there are no keys.
+// Keys are sent in clear text, so they should be used together with TLS.
+type APIKeys struct {
+	keys [][]byte
+}
+
+// NewAPIKeys returns authorization with the keys, authorization is disabled when keys are empty.
+func NewAPIKeys(keys []string) (*APIKeys, error) {
+
+	a := &APIKeys{}
+	for _, key := range keys {
+		if key == "" {
+			return nil, errors.New("api key is empty")
+		}
+		a.keys = append(a.keys, []byte(key))
+	}
+	return a, nil
+}
+
+// Enabled reports whether requests are checked.
+func (a *APIKeys) Enabled() bool {
+	return len(a.keys) > 0
+}
+
+// valid compares token with every key in constant time.
+func (a *APIKeys) valid(token string) bool {
+	var found int
+	for _, key := range a.keys {
+		found |= subtle.ConstantTimeCompare(key, []byte(token))
+	}
+	return found == 1
+}
+
+func (a *APIKeys) authorize(ctx context.Context, method string) error {
+
+	if !a.Enabled() || strings.HasPrefix(method, healthService) {
+		return nil
+	}
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return err
+	}
+	if !a.valid(token) {
+		return status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	return nil
+}
+
+// UnaryServerInterceptor rejects calls without a valid key with Unauthenticated status, health checks are allowed.
+func (a *APIKeys) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects streams without a valid key with Unauthenticated status, health checks are allowed.
+func (a *APIKeys) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// Middleware rejects HTTP requests without a valid key in Authorization header with 401 status.
+func (a *APIKeys) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.Enabled() {
+			scheme, token := "", ""
+			if parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2); len(parts) == 2 {
+				scheme, token = parts[0], parts[1]
+			}
+			if !strings.EqualFold(scheme, "bearer") || !a.valid(token) {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, "invalid or missing api key", http.StatusUnauthorized)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
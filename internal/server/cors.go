@@ -0,0 +1,84 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rs/cors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultAllowedMethods are methods of REST API, GraphQL and gRPC-Web.
+var DefaultAllowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodHead}
+
+// CORSConfig configures cross-origin requests of browser clients.
+type CORSConfig struct {
+	AllowedOrigins   []string      `yaml:"allowedOrigins"` // e.g. https://invest.example.com or https://*.example.com, cross-origin requests are denied when empty
+	AllowedMethods   []string      `yaml:"allowedMethods"` // DefaultAllowedMethods when empty
+	AllowedHeaders   []string      `yaml:"allowedHeaders"` // non simple request headers
+	ExposedHeaders   []string      `yaml:"exposedHeaders"` // response headers readable by scripts
+	AllowCredentials bool          `yaml:"allowCredentials"`
+	MaxAge           time.Duration `yaml:"maxAge" validate:"gte=0"` // of cached preflight response
+	Debug            bool          `yaml:"debug"`                   // logs CORS decisions to stdout
+}
+
+// CORS returns middleware handling cross-origin requests according to config.
+func CORS(conf CORSConfig) (func(http.Handler) http.Handler, error) {
+
+	if len(conf.AllowedOrigins) == 0 {
+		// empty list means any origin for cors package
+		return func(next http.Handler) http.Handler { return next }, nil
+	}
+	for _, origin := range conf.AllowedOrigins {
+		if err := validateOrigin(origin); err != nil {
+			return nil, err
+		}
+		if origin == "*" && conf.AllowCredentials {
+			return nil, errors.New("credentials must not be allowed for any origin")
+		}
+	}
+	for _, header := range append(append([]string{}, conf.AllowedHeaders...), conf.ExposedHeaders...) {
+		if header == "" || strings.ContainsAny(header, ": \t") {
+			return nil, fmt.Errorf("invalid CORS header %q", header)
+		}
+	}
+	if conf.MaxAge < 0 {
+		return nil, fmt.Errorf("CORS max age %s is negative", conf.MaxAge)
+	}
+
+	methods := conf.AllowedMethods
+	if len(methods) == 0 {
+		methods = DefaultAllowedMethods
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins:   conf.AllowedOrigins,
+		AllowedMethods:   methods,
+		AllowedHeaders:   conf.AllowedHeaders,
+		ExposedHeaders:   conf.ExposedHeaders,
+		AllowCredentials: conf.AllowCredentials,
+		MaxAge:           int(conf.MaxAge / time.Second),
+		Debug:            conf.Debug,
+	}).Handler, nil
+}
+
+// validateOrigin accepts *, scheme://host[:port] and origins with a single wildcard in host.
+func validateOrigin(origin string) error {
+
+	if origin == "*" {
+		return nil
+	}
+	if strings.Count(origin, "*") > 1 {
+		return fmt.Errorf("origin %q has more than one wildcard", origin)
+	}
+	u, err := url.Parse(strings.Replace(origin, "*", "wildcard", 1))
+	if err != nil {
+		return fmt.Errorf("invalid origin %q: %w", origin, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil ||
+		u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("origin %q is not scheme://host[:port]", origin)
+	}
+	return nil
+}
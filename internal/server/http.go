@@ -0,0 +1,68 @@
+// Package server configures CORS, timeouts, TLS and API key authorization of the public HTTP and gRPC listeners.
+package server
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+// Default timeouts of HTTP server.
+const (
+	DefaultReadTimeout       = 5 * time.Second
+	DefaultReadHeaderTimeout = 10 * time.Second
+	DefaultWriteTimeout      = 15 * time.Second
+	DefaultIdleTimeout       = 120 * time.Second
+)
+
+// HTTPConfig configures timeouts of HTTP server, zero timeout is replaced with its default.
+type HTTPConfig struct {
+	ReadTimeout       time.Duration `yaml:"readTimeout" validate:"gte=0"`       // of the whole request including body
+	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout" validate:"gte=0"` // of request headers
+	WriteTimeout      time.Duration `yaml:"writeTimeout" validate:"gte=0"`      // of the response since end of request headers
+	IdleTimeout       time.Duration `yaml:"idleTimeout" validate:"gte=0"`       // of keep-alive connection between requests
+}
+
+// NewHTTPServer returns HTTP server of the handler listening on the address, it serves HTTPS when tlsConf is not nil.
+func NewHTTPServer(addr string, conf HTTPConfig, tlsConf *tls.Config, handler http.Handler, logger *zap.Logger) (*http.Server, error) {
+
+	if handler == nil {
+		return nil, errors.New("handler provided to http server is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger provided to http server is nil")
+	}
+
+	timeouts := []struct {
+		name  string
+		value *time.Duration
+		def   time.Duration
+	}{
+		{"read", &conf.ReadTimeout, DefaultReadTimeout},
+		{"read header", &conf.ReadHeaderTimeout, DefaultReadHeaderTimeout},
+		{"write", &conf.WriteTimeout, DefaultWriteTimeout},
+		{"idle", &conf.IdleTimeout, DefaultIdleTimeout},
+	}
+	for _, t := range timeouts {
+		if *t.value < 0 {
+			return nil, fmt.Errorf("%s timeout %s is negative", t.name, *t.value)
+		}
+		if *t.value == 0 {
+			*t.value = t.def
+		}
+	}
+
+	return &http.Server{
+		Addr:              addr,
+		ReadTimeout:       conf.ReadTimeout,
+		WriteTimeout:      conf.WriteTimeout,
+		ReadHeaderTimeout: conf.ReadHeaderTimeout,
+		IdleTimeout:       conf.IdleTimeout,
+		TLSConfig:         tlsConf,
+		ErrorLog:          zap.NewStdLog(logger),
+		Handler:           handler,
+	}, nil
+}
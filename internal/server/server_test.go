@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCORS(t *testing.T) {
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	preflight := func(h http.Handler, origin, method string) http.Header {
+		req := httptest.NewRequest(http.MethodOptions, "/v1/alerts/rules", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", method)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Header()
+	}
+
+	mw, err := CORS(CORSConfig{
+		AllowedOrigins:   []string{"https://invest.example.com", "https://*.example.org"},
+		AllowCredentials: true,
+		MaxAge:           time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := mw(ok)
+	tests := []struct {
+		origin, method string
+		allowed        bool
+	}{
+		{"https://invest.example.com", http.MethodDelete, true},
+		{"https://app.example.org", http.MethodPut, true},
+		{"http://localhost:8080", http.MethodGet, false},
+		{"https://invest.example.com", http.MethodPatch, false},
+	}
+	for _, tt := range tests {
+		header := preflight(h, tt.origin, tt.method)
+		if allowed := header.Get("Access-Control-Allow-Origin") == tt.origin; allowed != tt.allowed {
+			t.Errorf("%s %s allowed = %v, want %v", tt.origin, tt.method, allowed, tt.allowed)
+		}
+		if tt.allowed && header.Get("Access-Control-Max-Age") != "60" {
+			t.Errorf("%s max age = %q", tt.origin, header.Get("Access-Control-Max-Age"))
+		}
+	}
+
+	// cross-origin requests are denied by default
+	mw, err = CORS(CORSConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header := preflight(mw(ok), "https://invest.example.com", http.MethodGet); header.Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf("default config allows origin %q", header.Get("Access-Control-Allow-Origin"))
+	}
+
+	invalid := []CORSConfig{
+		{AllowedOrigins: []string{"*"}, AllowCredentials: true},
+		{AllowedOrigins: []string{"invest.example.com"}},
+		{AllowedOrigins: []string{"https://invest.example.com/"}},
+		{AllowedOrigins: []string{"ftp://invest.example.com"}},
+		{AllowedOrigins: []string{"https://*.*.example.com"}},
+		{AllowedOrigins: []string{"*"}, AllowedHeaders: []string{"X-Token: 1"}},
+		{AllowedOrigins: []string{"*"}, MaxAge: -time.Second},
+	}
+	for _, conf := range invalid {
+		if _, err := CORS(conf); err == nil {
+			t.Errorf("CORS(%+v) error is nil", conf)
+		}
+	}
+}
+
+func TestNewHTTPServer(t *testing.T) {
+
+	s, err := NewHTTPServer(":0", HTTPConfig{WriteTimeout: time.Minute}, nil, http.NotFoundHandler(), zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.WriteTimeout != time.Minute || s.ReadTimeout != DefaultReadTimeout ||
+		s.ReadHeaderTimeout != DefaultReadHeaderTimeout || s.IdleTimeout != DefaultIdleTimeout {
+		t.Errorf("timeouts = %s %s %s %s", s.ReadTimeout, s.ReadHeaderTimeout, s.WriteTimeout, s.IdleTimeout)
+	}
+
+	if _, err := NewHTTPServer(":0", HTTPConfig{IdleTimeout: -time.Second}, nil, http.NotFoundHandler(), zap.NewNop()); err == nil {
+		t.Error("negative timeout is accepted")
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+
+	if _, err := NewAPIKeys([]string{""}); err == nil {
+		t.Error("empty key is accepted")
+	}
+	keys, err := NewAPIKeys([]string{"first", "second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(method, authorization string) codes.Code {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+		}
+		_, err := keys.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+	const method = "/invest.v1.InvestService/GetAccounts"
+	if code := call(method, "Bearer second"); code != codes.OK {
+		t.Errorf("valid key: %s", code)
+	}
+	if code := call(method, "Bearer third"); code != codes.Unauthenticated {
+		t.Errorf("invalid key: %s", code)
+	}
+	if code := call(method, ""); code != codes.Unauthenticated {
+		t.Errorf("missing key: %s", code)
+	}
+	if code := call("/grpc.health.v1.Health/Check", ""); code != codes.OK {
+		t.Errorf("health check: %s", code)
+	}
+
+	h := keys.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for authorization, want := range map[string]int{"bearer first": http.StatusOK, "Bearer": http.StatusUnauthorized,
+		"Basic first": http.StatusUnauthorized, "": http.StatusUnauthorized} {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		req.Header.Set("Authorization", authorization)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != want {
+			t.Errorf("authorization %q: status %d, want %d", authorization, rec.Code, want)
+		}
+	}
+
+	disabled, _ := NewAPIKeys(nil)
+	if _, err := disabled.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler); err != nil {
+		t.Errorf("disabled authorization: %v", err)
+	}
+}
+
+func TestLoadTLS(t *testing.T) {
+
+	conf, err := LoadTLS(TLSConfig{SelfSigned: true})
+	if err != nil || conf != nil {
+		t.Fatalf("disabled TLS = %v, %v", conf, err)
+	}
+
+	selfSigned, err := LoadTLS(TLSConfig{Enabled: true, SelfSigned: true}, "invest.local", "0.0.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(selfSigned.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := leaf.VerifyHostname("invest.local"); err != nil {
+		t.Error(err)
+	}
+	if err := leaf.VerifyHostname("127.0.0.1"); err != nil {
+		t.Error(err)
+	}
+
+	// certificate saved to files is loaded back
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	key, err := x509.MarshalPKCS8PrivateKey(selfSigned.Certificates[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, certFile, "CERTIFICATE", selfSigned.Certificates[0].Certificate[0])
+	writePEM(t, keyFile, "PRIVATE KEY", key)
+	loaded, err := LoadTLS(TLSConfig{Enabled: true, CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(loaded.Certificates[0].Certificate[0]) != string(selfSigned.Certificates[0].Certificate[0]) {
+		t.Error("loaded certificate differs from saved one")
+	}
+
+	invalid := []TLSConfig{
+		{Enabled: true},
+		{Enabled: true, CertFile: certFile},
+		{Enabled: true, SelfSigned: true, CertFile: certFile, KeyFile: keyFile},
+		{Enabled: true, CertFile: filepath.Join(dir, "missing.pem"), KeyFile: keyFile},
+	}
+	for _, conf := range invalid {
+		if _, err := LoadTLS(conf); err == nil {
+			t.Errorf("LoadTLS(%+v) error is nil", conf)
+		}
+	}
+}
+
+func TestClientTLS(t *testing.T) {
+
+	serverConf, err := LoadTLS(TLSConfig{Enabled: true, SelfSigned: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = serverConf
+	srv.StartTLS()
+	defer srv.Close()
+
+	get := func(conf *tls.Config) error {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: conf}}
+		defer client.CloseIdleConnections()
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	if err := get(ClientTLS(serverConf)); err != nil {
+		t.Errorf("certificate of the server is not trusted: %v", err)
+	}
+
+	otherConf, err := LoadTLS(TLSConfig{Enabled: true, SelfSigned: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := get(ClientTLS(otherConf)); err == nil {
+		t.Error("certificate of another server is trusted")
+	}
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+)
+
+const selfSignedValidity = 365 * 24 * time.Hour
+
+// TLSConfig configures TLS of HTTP and gRPC listeners.
+type TLSConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CertFile   string `yaml:"certFile" validate:"required_with=KeyFile"` // PEM encoded certificate chain
+	KeyFile    string `yaml:"keyFile" validate:"required_with=CertFile"` // PEM encoded private key
+	SelfSigned bool   `yaml:"selfSigned"`                                // generates certificate on start instead of loading it, for development only
+}
+
+// LoadTLS returns server TLS config with certificate of config or nil when TLS is disabled.
+// Self-signed certificate is issued for hosts, localhost and loopback addresses.
+func LoadTLS(conf TLSConfig, hosts ...string) (*tls.Config, error) {
+
+	if !conf.Enabled {
+		return nil, nil
+	}
+
+	var (
+		cert tls.Certificate
+		err  error
+	)
+	switch {
+	case conf.SelfSigned && (conf.CertFile != "" || conf.KeyFile != ""):
+		return nil, errors.New("self-signed certificate is requested together with certificate files")
+	case conf.SelfSigned:
+		cert, err = selfSigned(hosts)
+		if err != nil {
+			return nil, fmt.Errorf("problem while generating self-signed certificate: %w", err)
+		}
+	case conf.CertFile == "" || conf.KeyFile == "":
+		return nil, errors.New("both certificate and key files are required for TLS")
+	default:
+		cert, err = tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("problem while loading TLS certificate: %w", err)
+		}
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ClientTLS returns client TLS config trusting only certificates of the server config, so the service
+// is able to call its own listeners by any address, e.g. localhost, even with self-signed certificate.
+func ClientTLS(serverConf *tls.Config) *tls.Config {
+
+	var trusted [][]byte
+	for _, cert := range serverConf.Certificates {
+		if len(cert.Certificate) > 0 {
+			trusted = append(trusted, cert.Certificate[0])
+		}
+	}
+	return &tls.Config{
+		// chain and host name are not verified, the leaf certificate is compared below instead
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) > 0 {
+				for _, cert := range trusted {
+					if bytes.Equal(rawCerts[0], cert) {
+						return nil
+					}
+				}
+			}
+			return errors.New("server certificate is not trusted")
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+func selfSigned(hosts []string) (tls.Certificate, error) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"goinvest"}, CommonName: "localhost"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	for _, host := range hosts {
+		if host == "" {
+			continue
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			if !ip.IsUnspecified() {
+				template.IPAddresses = append(template.IPAddresses, ip)
+			}
+		} else if host != "localhost" {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
@@ -0,0 +1,121 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/alert"
+)
+
+func (r *mutationResolver) InvestServiceGetAlertRules(ctx context.Context, in *gqlmodels.AlertRulesRequestInput) (*gqlmodels.AlertRulesResponse, error) {
+	req := &pb.AlertRulesRequest{}
+	if in != nil {
+		req.Account = convertGqlAccountToPb(in.Account)
+	}
+	respPb, err := alert.Rules(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]*gqlmodels.AlertRule, 0, len(respPb.Rules))
+	for _, rule := range respPb.Rules {
+		rules = append(rules, convertPbAlertRuleToGql(rule))
+	}
+	return &gqlmodels.AlertRulesResponse{Rules: rules}, nil
+}
+
+// InvestServiceSaveAlertRule creates rule without id or updates existing one.
+func (r *mutationResolver) InvestServiceSaveAlertRule(ctx context.Context, in *gqlmodels.SaveAlertRuleRequestInput) (*gqlmodels.SaveAlertRuleResponse, error) {
+	req := &pb.SaveAlertRuleRequest{}
+	if in != nil && in.Rule != nil {
+		req.Rule = &pb.AlertRule{
+			Name:      stringValue(in.Rule.Name),
+			Account:   convertGqlAccountToPb(in.Rule.Account),
+			Figi:      stringValue(in.Rule.Figi),
+			Threshold: floatValue(in.Rule.Threshold),
+		}
+		if in.Rule.ID != nil {
+			req.Rule.Id = int64(*in.Rule.ID)
+		}
+		if in.Rule.Type != nil {
+			req.Rule.Type = pb.AlertType(pb.AlertType_value[in.Rule.Type.String()])
+		}
+		// rules are enabled unless explicitly disabled
+		req.Rule.Enabled = in.Rule.Enabled == nil || *in.Rule.Enabled
+	}
+	respPb, err := alert.SaveRule(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodels.SaveAlertRuleResponse{Rule: convertPbAlertRuleToGql(respPb.Rule)}, nil
+}
+
+func (r *mutationResolver) InvestServiceDeleteAlertRule(ctx context.Context, in *gqlmodels.DeleteAlertRuleRequestInput) (*bool, error) {
+	req := &pb.DeleteAlertRuleRequest{}
+	if in != nil && in.ID != nil {
+		req.Id = int64(*in.ID)
+	}
+	if _, err := alert.DeleteRule(ctx, r.storage, req); err != nil {
+		return nil, err
+	}
+	deleted := true
+	return &deleted, nil
+}
+
+func (r *mutationResolver) InvestServiceGetAlertEvents(ctx context.Context, in *gqlmodels.AlertEventsRequestInput) (*gqlmodels.AlertEventsResponse, error) {
+	req := &pb.AlertEventsRequest{}
+	if in != nil {
+		req.Account = convertGqlAccountToPb(in.Account)
+		req.From = stringValue(in.From)
+		if in.RuleID != nil {
+			req.RuleId = int64(*in.RuleID)
+		}
+		if in.Limit != nil {
+			req.Limit = int32(*in.Limit)
+		}
+	}
+	respPb, err := alert.Events(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*gqlmodels.AlertEvent, 0, len(respPb.Events))
+	for _, e := range respPb.Events {
+		id, ruleID := int(e.Id), int(e.RuleId)
+		alertType := gqlmodels.AlertType(e.Type.String())
+		event := &gqlmodels.AlertEvent{
+			ID:          &id,
+			RuleID:      &ruleID,
+			Type:        &alertType,
+			Figi:        &e.Figi,
+			Threshold:   &e.Threshold,
+			Value:       &e.Value,
+			Message:     &e.Message,
+			TriggeredAt: &e.TriggeredAt,
+		}
+		if e.Account != nil {
+			event.Account = convertPbAccountsToGql([]*pb.Account{e.Account})[0]
+		}
+		events = append(events, event)
+	}
+	return &gqlmodels.AlertEventsResponse{Events: events}, nil
+}
+
+func convertPbAlertRuleToGql(rule *pb.AlertRule) *gqlmodels.AlertRule {
+	id := int(rule.Id)
+	alertType := gqlmodels.AlertType(rule.Type.String())
+	r := &gqlmodels.AlertRule{
+		ID:        &id,
+		Type:      &alertType,
+		Name:      &rule.Name,
+		Figi:      &rule.Figi,
+		Threshold: &rule.Threshold,
+		Enabled:   &rule.Enabled,
+		CreatedAt: &rule.CreatedAt,
+	}
+	if rule.Account != nil {
+		r.Account = convertPbAccountsToGql([]*pb.Account{rule.Account})[0]
+	}
+	if rule.TriggeredAt != "" {
+		r.TriggeredAt = &rule.TriggeredAt
+	}
+	return r
+}
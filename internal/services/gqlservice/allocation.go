@@ -0,0 +1,61 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/allocation"
+)
+
+func (r *mutationResolver) InvestServiceGetAllocation(ctx context.Context, in *gqlmodels.AllocationRequestInput) (*gqlmodels.AllocationResponse, error) {
+	if in == nil {
+		in = &gqlmodels.AllocationRequestInput{}
+	}
+	allocationPb, err := allocation.Allocation(ctx, r.Provider(), r.storage, &pb.AllocationRequest{
+		Account:       convertGqlAccountToPb(in.Account),
+		CurrencyRates: convertGqlRatesToPb(in.CurrencyRates),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodels.AllocationResponse{
+		Total:            &allocationPb.Total,
+		ByInstrumentType: convertPbWeightsToGql(allocationPb.ByInstrumentType),
+		ByCurrency:       convertPbWeightsToGql(allocationPb.ByCurrency),
+		BySector:         convertPbWeightsToGql(allocationPb.BySector),
+		ByCountry:        convertPbWeightsToGql(allocationPb.ByCountry),
+		ByIssuer:         convertPbWeightsToGql(allocationPb.ByIssuer),
+	}, nil
+}
+
+func (r *mutationResolver) InvestServiceSaveInstrument(ctx context.Context, in *gqlmodels.SaveInstrumentRequestInput) (*bool, error) {
+	req := &pb.SaveInstrumentRequest{}
+	if in != nil && in.Instrument != nil {
+		req.Instrument = &pb.Instrument{
+			Figi:    stringValue(in.Instrument.Figi),
+			Ticker:  stringValue(in.Instrument.Ticker),
+			Isin:    stringValue(in.Instrument.Isin),
+			Name:    stringValue(in.Instrument.Name),
+			Sector:  stringValue(in.Instrument.Sector),
+			Country: stringValue(in.Instrument.Country),
+			Issuer:  stringValue(in.Instrument.Issuer),
+		}
+	}
+	if _, err := allocation.SaveInstrument(ctx, r.storage, req); err != nil {
+		return nil, err
+	}
+	saved := true
+	return &saved, nil
+}
+
+func convertPbWeightsToGql(pbWeights []*pb.AllocationWeight) []*gqlmodels.AllocationWeight {
+	gqlWeights := make([]*gqlmodels.AllocationWeight, 0, len(pbWeights))
+	for _, pbWeight := range pbWeights {
+		gqlWeights = append(gqlWeights, &gqlmodels.AllocationWeight{
+			Key:    &pbWeight.Key,
+			Value:  &pbWeight.Value,
+			Weight: &pbWeight.Weight,
+		})
+	}
+	return gqlWeights
+}
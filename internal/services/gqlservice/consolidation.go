@@ -0,0 +1,60 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/consolidation"
+)
+
+func (r *mutationResolver) InvestServiceGetConsolidatedPortfolio(ctx context.Context) (*gqlmodels.ConsolidatedPortfolioResponse, error) {
+	portfolioPb, err := consolidation.Portfolio(ctx, r.providerService.Providers())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gqlmodels.ConsolidatedPortfolioResponse{
+		Positions:  make([]*gqlmodels.ConsolidatedPosition, 0, len(portfolioPb.Positions)),
+		Currencies: convertPbCurrenciesToGql(portfolioPb.Currencies),
+		Sources:    make([]*gqlmodels.PortfolioSource, 0, len(portfolioPb.Sources)),
+		Failures:   make([]*gqlmodels.SourceFailure, 0, len(portfolioPb.Failures)),
+	}
+	for _, p := range portfolioPb.Positions {
+		sources := make([]*gqlmodels.PositionSource, 0, len(p.Sources))
+		for _, s := range p.Sources {
+			sources = append(sources, &gqlmodels.PositionSource{
+				Provider:  &s.Provider,
+				AccountID: &s.AccountId,
+				Balance:   &s.Balance,
+				Value:     convertPbYieldToGql(s.Value),
+			})
+		}
+		resp.Positions = append(resp.Positions, &gqlmodels.ConsolidatedPosition{
+			Figi:           &p.Figi,
+			Ticker:         &p.Ticker,
+			Isin:           &p.Isin,
+			Name:           &p.Name,
+			InstrumentType: &p.InstrumentType,
+			Balance:        &p.Balance,
+			Value:          convertPbYieldToGql(p.Value),
+			ExpectedYield:  convertPbYieldToGql(p.ExpectedYield),
+			Sources:        sources,
+		})
+	}
+	for _, s := range portfolioPb.Sources {
+		positions := int(s.Positions)
+		resp.Sources = append(resp.Sources, &gqlmodels.PortfolioSource{
+			Provider:  &s.Provider,
+			Account:   convertPbAccountsToGql([]*pb.Account{s.Account})[0],
+			Positions: &positions,
+		})
+	}
+	for _, f := range portfolioPb.Failures {
+		resp.Failures = append(resp.Failures, &gqlmodels.SourceFailure{
+			Provider:  &f.Provider,
+			AccountID: &f.AccountId,
+			Error:     &f.Error,
+		})
+	}
+	return resp, nil
+}
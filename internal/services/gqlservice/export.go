@@ -0,0 +1,36 @@
+package gqlservice
+
+import (
+	"context"
+	"encoding/base64"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/export"
+)
+
+// InvestServiceExportReport renders report of positions, operations, pnl or income, content is base64 encoded.
+func (r *mutationResolver) InvestServiceExportReport(ctx context.Context, in *gqlmodels.ExportReportRequestInput) (*gqlmodels.ExportReportResponse, error) {
+	if in == nil {
+		in = &gqlmodels.ExportReportRequestInput{}
+	}
+
+	respPb, err := export.Report(ctx, r.Provider(), &pb.ExportReportRequest{
+		Account: convertGqlAccountToPb(in.Account),
+		Report:  stringValue(in.Report),
+		Format:  stringValue(in.Format),
+		Columns: in.Columns,
+		Locale:  stringValue(in.Locale),
+		From:    stringValue(in.From),
+		To:      stringValue(in.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	content := base64.StdEncoding.EncodeToString(respPb.Content)
+	return &gqlmodels.ExportReportResponse{
+		Filename:    &respPb.Filename,
+		ContentType: &respPb.ContentType,
+		Content:     &content,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/providers/manual"
+)
+
+func (r *mutationResolver) InvestServiceGetManualAccounts(ctx context.Context) (*gqlmodels.ManualAccountsResponse, error) {
+	accountsPb, err := manual.Accounts(ctx, r.storage, &pb.ManualAccountsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*gqlmodels.ManualAccount, 0, len(accountsPb.Accounts))
+	for _, a := range accountsPb.Accounts {
+		accountType := gqlmodels.AccountType(a.AccountType.String())
+		accounts = append(accounts, &gqlmodels.ManualAccount{
+			AccountID:   &a.AccountId,
+			AccountType: &accountType,
+			Name:        &a.Name,
+		})
+	}
+	return &gqlmodels.ManualAccountsResponse{Accounts: accounts}, nil
+}
+
+func (r *mutationResolver) InvestServiceSaveManualAccount(ctx context.Context, in *gqlmodels.SaveManualAccountRequestInput) (*gqlmodels.SaveManualAccountResponse, error) {
+	req := &pb.SaveManualAccountRequest{}
+	if in != nil && in.Account != nil {
+		req.Account = &pb.ManualAccount{
+			AccountId: stringValue(in.Account.AccountID),
+			Name:      stringValue(in.Account.Name),
+		}
+		if in.Account.AccountType != nil {
+			req.Account.AccountType = pb.AccountType(pb.AccountType_value[in.Account.AccountType.String()])
+		}
+	}
+	accountPb, err := manual.SaveAccount(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodels.SaveManualAccountResponse{
+		Account: convertPbAccountsToGql([]*pb.Account{accountPb.Account})[0],
+	}, nil
+}
+
+func (r *mutationResolver) InvestServiceDeleteManualAccount(ctx context.Context, in *gqlmodels.DeleteManualAccountRequestInput) (*bool, error) {
+	req := &pb.DeleteManualAccountRequest{}
+	if in != nil {
+		req.AccountId = stringValue(in.AccountID)
+	}
+	if _, err := manual.DeleteAccount(ctx, r.storage, req); err != nil {
+		return nil, err
+	}
+	deleted := true
+	return &deleted, nil
+}
+
+func (r *mutationResolver) InvestServiceGetManualPositions(ctx context.Context, in *gqlmodels.ManualPositionsRequestInput) (*gqlmodels.ManualPositionsResponse, error) {
+	req := &pb.ManualPositionsRequest{}
+	if in != nil {
+		req.AccountId = stringValue(in.AccountID)
+	}
+	positionsPb, err := manual.Positions(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*gqlmodels.ManualPosition, 0, len(positionsPb.Positions))
+	for _, p := range positionsPb.Positions {
+		positions = append(positions, &gqlmodels.ManualPosition{
+			Figi:           &p.Figi,
+			Ticker:         &p.Ticker,
+			Isin:           &p.Isin,
+			Name:           &p.Name,
+			InstrumentType: &p.InstrumentType,
+			Balance:        &p.Balance,
+			AveragePrice:   &p.AveragePrice,
+			Price:          &p.Price,
+			Currency:       &p.Currency,
+		})
+	}
+	return &gqlmodels.ManualPositionsResponse{Positions: positions}, nil
+}
+
+func (r *mutationResolver) InvestServiceSaveManualPosition(ctx context.Context, in *gqlmodels.SaveManualPositionRequestInput) (*bool, error) {
+	req := &pb.SaveManualPositionRequest{}
+	if in != nil {
+		req.AccountId = stringValue(in.AccountID)
+		if p := in.Position; p != nil {
+			req.Position = &pb.ManualPosition{
+				Figi:           stringValue(p.Figi),
+				Ticker:         stringValue(p.Ticker),
+				Isin:           stringValue(p.Isin),
+				Name:           stringValue(p.Name),
+				InstrumentType: stringValue(p.InstrumentType),
+				Balance:        floatValue(p.Balance),
+				AveragePrice:   floatValue(p.AveragePrice),
+				Price:          floatValue(p.Price),
+				Currency:       stringValue(p.Currency),
+			}
+		}
+	}
+	if _, err := manual.SavePosition(ctx, r.storage, req); err != nil {
+		return nil, err
+	}
+	saved := true
+	return &saved, nil
+}
+
+func (r *mutationResolver) InvestServiceDeleteManualPosition(ctx context.Context, in *gqlmodels.DeleteManualPositionRequestInput) (*bool, error) {
+	req := &pb.DeleteManualPositionRequest{}
+	if in != nil {
+		req.AccountId = stringValue(in.AccountID)
+		req.Key = stringValue(in.Key)
+	}
+	if _, err := manual.DeletePosition(ctx, r.storage, req); err != nil {
+		return nil, err
+	}
+	deleted := true
+	return &deleted, nil
+}
+
+func (r *mutationResolver) InvestServiceUpdateManualPrice(ctx context.Context, in *gqlmodels.UpdateManualPriceRequestInput) (*gqlmodels.UpdateManualPriceResponse, error) {
+	req := &pb.UpdateManualPriceRequest{}
+	if in != nil {
+		req.AccountId = stringValue(in.AccountID)
+		req.Key = stringValue(in.Key)
+		req.Price = floatValue(in.Price)
+	}
+	updatedPb, err := manual.UpdatePrice(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	updated := int(updatedPb.Updated)
+	return &gqlmodels.UpdateManualPriceResponse{Updated: &updated}, nil
+}
+
+func floatValue(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
@@ -0,0 +1,73 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/notify"
+)
+
+func (r *mutationResolver) InvestServiceGetNotificationChannels(ctx context.Context, in *gqlmodels.NotificationChannelsRequestInput) (*gqlmodels.NotificationChannelsResponse, error) {
+	req := &pb.NotificationChannelsRequest{}
+	if in != nil {
+		req.User = stringValue(in.User)
+	}
+	respPb, err := notify.Channels(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	channels := make([]*gqlmodels.NotificationChannel, 0, len(respPb.Channels))
+	for _, c := range respPb.Channels {
+		channels = append(channels, convertPbNotificationChannelToGql(c))
+	}
+	return &gqlmodels.NotificationChannelsResponse{Channels: channels}, nil
+}
+
+// InvestServiceSaveNotificationChannel creates channel without id or updates existing one, empty secret keeps the stored one.
+func (r *mutationResolver) InvestServiceSaveNotificationChannel(ctx context.Context, in *gqlmodels.SaveNotificationChannelRequestInput) (*gqlmodels.SaveNotificationChannelResponse, error) {
+	req := &pb.SaveNotificationChannelRequest{}
+	if in != nil && in.Channel != nil {
+		req.Channel = &pb.NotificationChannel{
+			User:   stringValue(in.Channel.User),
+			Type:   stringValue(in.Channel.Type),
+			Target: stringValue(in.Channel.Target),
+			Secret: stringValue(in.Channel.Secret),
+			Kinds:  in.Channel.Kinds,
+			// channels are enabled unless explicitly disabled
+			Enabled: in.Channel.Enabled == nil || *in.Channel.Enabled,
+		}
+		if in.Channel.ID != nil {
+			req.Channel.Id = int64(*in.Channel.ID)
+		}
+	}
+	respPb, err := notify.SaveChannel(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodels.SaveNotificationChannelResponse{Channel: convertPbNotificationChannelToGql(respPb.Channel)}, nil
+}
+
+func (r *mutationResolver) InvestServiceDeleteNotificationChannel(ctx context.Context, in *gqlmodels.DeleteNotificationChannelRequestInput) (*bool, error) {
+	req := &pb.DeleteNotificationChannelRequest{}
+	if in != nil && in.ID != nil {
+		req.Id = int64(*in.ID)
+	}
+	if _, err := notify.DeleteChannel(ctx, r.storage, req); err != nil {
+		return nil, err
+	}
+	deleted := true
+	return &deleted, nil
+}
+
+func convertPbNotificationChannelToGql(c *pb.NotificationChannel) *gqlmodels.NotificationChannel {
+	id := int(c.Id)
+	return &gqlmodels.NotificationChannel{
+		ID:        &id,
+		User:      &c.User,
+		Type:      &c.Type,
+		Target:    &c.Target,
+		Kinds:     c.Kinds,
+		Enabled:   &c.Enabled,
+		CreatedAt: &c.CreatedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+)
+
+func (r *mutationResolver) InvestServiceGetOperations(ctx context.Context, in *gqlmodels.OperationsRequestInput) (*gqlmodels.OperationsResponse, error) {
+	if in == nil {
+		in = &gqlmodels.OperationsRequestInput{}
+	}
+	operationsPb, err := r.Provider().Operations(ctx, &pb.OperationsRequest{
+		Account: convertGqlAccountToPb(in.Account),
+		From:    stringValue(in.From),
+		To:      stringValue(in.To),
+		Figi:    stringValue(in.Figi),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodels.OperationsResponse{
+		Operations: convertPbOperationsToGql(operationsPb.Operations),
+	}, nil
+}
+
+func convertPbOperationsToGql(pbOperations []*pb.Operation) []*gqlmodels.Operation {
+	gqlOperations := make([]*gqlmodels.Operation, 0, len(pbOperations))
+	for _, pbOperation := range pbOperations {
+		quantity := int(pbOperation.Quantity)
+		operationType := gqlmodels.OperationType(pbOperation.OperationType.String())
+		gqlOperations = append(gqlOperations, &gqlmodels.Operation{
+			ID:             &pbOperation.Id,
+			OperationType:  &operationType,
+			Figi:           &pbOperation.Figi,
+			InstrumentType: &pbOperation.InstrumentType,
+			Date:           &pbOperation.Date,
+			Quantity:       &quantity,
+			Price:          &pbOperation.Price,
+			Payment:        &pbOperation.Payment,
+			Currency:       &pbOperation.Currency,
+			Commission:     convertPbYieldToGql(pbOperation.Commission),
+			Isin:           &pbOperation.Isin,
+			Ticker:         &pbOperation.Ticker,
+		})
+	}
+	return gqlOperations
+}
+
+// convertGqlAccountToPb converts optional account input, nil input results in nil account.
+func convertGqlAccountToPb(in *gqlmodels.AccountInput) *pb.Account {
+	if in == nil {
+		return nil
+	}
+	account := &pb.Account{AccountId: stringValue(in.AccountID), Provider: stringValue(in.Provider)}
+	if in.AccountType != nil {
+		account.AccountType = pb.AccountType(pb.AccountType_value[in.AccountType.String()])
+	}
+	return account
+}
+
+func convertPbYieldToGql(pbYield *pb.Yield) *gqlmodels.Yield {
+	if pbYield == nil {
+		return nil
+	}
+	return &gqlmodels.Yield{
+		Currency: &pbYield.Currency,
+		Value:    &pbYield.Value,
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,28 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+)
+
+func (r *mutationResolver) InvestServiceGetQuote(ctx context.Context, in *gqlmodels.QuoteRequestInput) (*gqlmodels.QuoteResponse, error) {
+	req := &pb.QuoteRequest{}
+	if in != nil {
+		req.Figi = stringValue(in.Figi)
+	}
+	quotePb, err := r.Provider().Quote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	lot := int(quotePb.Quote.Lot)
+	return &gqlmodels.QuoteResponse{
+		Quote: &gqlmodels.Quote{
+			Figi:     &quotePb.Quote.Figi,
+			Ticker:   &quotePb.Quote.Ticker,
+			Price:    &quotePb.Quote.Price,
+			Currency: &quotePb.Quote.Currency,
+			Lot:      &lot,
+		},
+	}, nil
+}
@@ -0,0 +1,90 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/rebalance"
+)
+
+func (r *mutationResolver) InvestServiceSetTargetWeights(ctx context.Context, in *gqlmodels.SetTargetWeightsRequestInput) (*bool, error) {
+	if in == nil {
+		in = &gqlmodels.SetTargetWeightsRequestInput{}
+	}
+	req := &pb.SetTargetWeightsRequest{
+		Account: convertGqlAccountToPb(in.Account),
+		Weights: make([]*pb.TargetWeight, 0, len(in.Weights)),
+	}
+	for _, w := range in.Weights {
+		weight := &pb.TargetWeight{Figi: stringValue(w.Figi)}
+		if w.Weight != nil {
+			weight.Weight = *w.Weight
+		}
+		req.Weights = append(req.Weights, weight)
+	}
+	if _, err := rebalance.SetTargetWeights(ctx, r.storage, req); err != nil {
+		return nil, err
+	}
+	saved := true
+	return &saved, nil
+}
+
+func (r *mutationResolver) InvestServiceGetTargetWeights(ctx context.Context, in *gqlmodels.TargetWeightsRequestInput) (*gqlmodels.TargetWeightsResponse, error) {
+	req := &pb.TargetWeightsRequest{}
+	if in != nil {
+		req.Account = convertGqlAccountToPb(in.Account)
+	}
+	weightsPb, err := rebalance.TargetWeights(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	weights := make([]*gqlmodels.TargetWeight, 0, len(weightsPb.Weights))
+	for _, w := range weightsPb.Weights {
+		weights = append(weights, &gqlmodels.TargetWeight{
+			Figi:   &w.Figi,
+			Weight: &w.Weight,
+		})
+	}
+	return &gqlmodels.TargetWeightsResponse{Weights: weights}, nil
+}
+
+func (r *mutationResolver) InvestServiceRebalance(ctx context.Context, in *gqlmodels.RebalanceRequestInput) (*gqlmodels.RebalanceResponse, error) {
+	if in == nil {
+		in = &gqlmodels.RebalanceRequestInput{}
+	}
+	req := &pb.RebalanceRequest{
+		Account:       convertGqlAccountToPb(in.Account),
+		CurrencyRates: convertGqlRatesToPb(in.CurrencyRates),
+	}
+	if in.Tolerance != nil {
+		req.Tolerance = *in.Tolerance
+	}
+	planPb, err := rebalance.Rebalance(ctx, r.Provider(), r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*gqlmodels.RebalanceOrder, 0, len(planPb.Orders))
+	for _, o := range planPb.Orders {
+		lots, quantity := int(o.Lots), int(o.Quantity)
+		operationType := gqlmodels.OperationType(o.OperationType.String())
+		orders = append(orders, &gqlmodels.RebalanceOrder{
+			Figi:          &o.Figi,
+			Ticker:        &o.Ticker,
+			OperationType: &operationType,
+			Lots:          &lots,
+			Quantity:      &quantity,
+			Price:         &o.Price,
+			Currency:      &o.Currency,
+			Amount:        &o.Amount,
+			CurrentWeight: &o.CurrentWeight,
+			TargetWeight:  &o.TargetWeight,
+			PlannedWeight: &o.PlannedWeight,
+		})
+	}
+	return &gqlmodels.RebalanceResponse{
+		Total:      &planPb.Total,
+		CashBefore: &planPb.CashBefore,
+		CashAfter:  &planPb.CashAfter,
+		Orders:     orders,
+	}, nil
+}
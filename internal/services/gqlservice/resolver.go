@@ -21,15 +21,17 @@ type Resolver struct {
 }
 
 func (r *mutationResolver) InvestServiceGetPortfolio(ctx context.Context, in *gqlmodels.PortfolioRequestInput) (*gqlmodels.PortfolioResponse, error) {
-	portfolioPb, err := r.Provider().Portfolio(ctx, &pb.PortfolioRequest{Account: &pb.Account{
-		AccountId: *in.Account.AccountID,
-	}})
+	if in == nil {
+		in = &gqlmodels.PortfolioRequestInput{}
+	}
+	portfolioPb, err := r.Provider().Portfolio(ctx, &pb.PortfolioRequest{Account: convertGqlAccountToPb(in.Account)})
 	if err != nil {
 		return nil, err
 	}
 	positionsGql := convertPbPositionsToGql(portfolioPb.Positions)
 	return &gqlmodels.PortfolioResponse{
-		Positions: positionsGql,
+		Positions:  positionsGql,
+		Currencies: convertPbCurrenciesToGql(portfolioPb.Currencies),
 	}, err
 }
 
@@ -49,31 +51,34 @@ func convertPbPositionsToGql(pbPositions []*pb.Position) []*gqlmodels.Position {
 	for _, pbPosition := range pbPositions {
 		lots := int(pbPosition.Lots)
 		gqlPosition = append(gqlPosition, &gqlmodels.Position{
-			Figi:           &pbPosition.Figi,
-			Ticker:         &pbPosition.Ticker,
-			Isin:           &pbPosition.Isin,
-			InstrumentType: &pbPosition.InstrumentType,
-			Balance:        &pbPosition.Balance,
-			Blocked:        &pbPosition.Blocked,
-			ExpectedYield: &gqlmodels.Yield{
-				Currency: &pbPosition.ExpectedYield.Currency,
-				Value:    &pbPosition.ExpectedYield.Value,
-			},
-			Lots: &lots,
-			AveragePositionPrice: &gqlmodels.Yield{
-				Currency: &pbPosition.AveragePositionPrice.Currency,
-				Value:    &pbPosition.AveragePositionPrice.Value,
-			},
-			AveragePositionPriceNoNkd: &gqlmodels.Yield{
-				Currency: &pbPosition.AveragePositionPriceNoNkd.Currency,
-				Value:    &pbPosition.AveragePositionPriceNoNkd.Value,
-			},
-			Name: &pbPosition.Name,
+			Figi:                      &pbPosition.Figi,
+			Ticker:                    &pbPosition.Ticker,
+			Isin:                      &pbPosition.Isin,
+			InstrumentType:            &pbPosition.InstrumentType,
+			Balance:                   &pbPosition.Balance,
+			Blocked:                   &pbPosition.Blocked,
+			ExpectedYield:             convertPbYieldToGql(pbPosition.ExpectedYield),
+			Lots:                      &lots,
+			AveragePositionPrice:      convertPbYieldToGql(pbPosition.AveragePositionPrice),
+			AveragePositionPriceNoNkd: convertPbYieldToGql(pbPosition.AveragePositionPriceNoNkd),
+			Name:                      &pbPosition.Name,
 		})
 	}
 	return gqlPosition
 }
 
+func convertPbCurrenciesToGql(pbCurrencies []*pb.CurrencyBalance) []*gqlmodels.CurrencyBalance {
+	gqlCurrencies := make([]*gqlmodels.CurrencyBalance, 0, len(pbCurrencies))
+	for _, pbCurrency := range pbCurrencies {
+		gqlCurrencies = append(gqlCurrencies, &gqlmodels.CurrencyBalance{
+			Currency: &pbCurrency.Currency,
+			Balance:  &pbCurrency.Balance,
+			Blocked:  &pbCurrency.Blocked,
+		})
+	}
+	return gqlCurrencies
+}
+
 func convertPbAccountsToGql(pbAccounts []*pb.Account) []*gqlmodels.Account {
 	gqlAccounts := make([]*gqlmodels.Account, 0, len(pbAccounts))
 	var err error
@@ -86,18 +91,15 @@ func convertPbAccountsToGql(pbAccounts []*pb.Account) []*gqlmodels.Account {
 		gqlAccounts = append(gqlAccounts, &gqlmodels.Account{
 			AccountID:   &pbAccount.AccountId,
 			AccountType: &accountType,
+			Provider:    &pbAccount.Provider,
 		})
 	}
 	return gqlAccounts
 }
 
-func (r *mutationResolver) Provider() invest.Provider {
-	provider, err := r.providerService.Provider(invest.ProviderTinkoff)
-	if err != nil {
-		r.logger.Error("cannon get provider", zap.Error(err))
-		return nil
-	}
-	return provider
+// Provider returns provider which serves accounts of all enabled providers.
+func (r *Resolver) Provider() invest.Provider {
+	return r.providerService.Router()
 }
 
 func (r *queryResolver) Dummy(ctx context.Context) (*bool, error) {
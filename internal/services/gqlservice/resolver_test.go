@@ -0,0 +1,85 @@
+package gqlservice
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"go.uber.org/zap"
+	gqlapi "goinvest/gen/gql/generated"
+	"goinvest/internal/invest"
+	_ "goinvest/internal/providers/fake"
+	"goinvest/internal/services/providerservice"
+	"testing"
+)
+
+type testStorage struct{ invest.Storage }
+
+func (testStorage) Instruments(context.Context, []string) (map[string]invest.Instrument, error) {
+	return nil, nil
+}
+
+type testCache struct{ invest.Cache }
+
+func newTestClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	conf := &invest.ProvidersConfig{
+		List: []invest.ProviderConfig{{
+			Name:    "fake",
+			Enabled: true,
+			Options: map[string]string{"fixture": "../../providers/fake/testdata/fixture.yaml"},
+		}},
+	}
+	providerService, err := providerservice.NewProviderService(conf, testStorage{}, testCache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver, err := NewResolver(providerService, testStorage{}, testCache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := handler.New(gqlapi.NewExecutableSchema(gqlapi.Config{Resolvers: resolver}))
+	server.AddTransport(transport.POST{})
+	return client.New(server)
+}
+
+func TestResolver(t *testing.T) {
+
+	c := newTestClient(t)
+
+	var accounts struct {
+		InvestServiceGetAccounts struct {
+			Accounts []struct {
+				AccountID   string
+				AccountType string
+				Provider    string
+			}
+		}
+	}
+	c.MustPost(`mutation { investServiceGetAccounts { accounts { accountId accountType provider } } }`, &accounts)
+	if got := accounts.InvestServiceGetAccounts.Accounts; len(got) != 2 || got[1].AccountType != "TYPE_IIS" || got[1].Provider != "fake" {
+		t.Fatalf("unexpected accounts %v", got)
+	}
+
+	var portfolio struct {
+		InvestServiceGetPortfolio struct {
+			Positions []struct {
+				Ticker  string
+				Balance float64
+			}
+		}
+	}
+	c.MustPost(`mutation($id: String) { investServiceGetPortfolio(in: {account: {accountId: $id, provider: "fake"}}) { positions { ticker balance } } }`,
+		&portfolio, client.Var("id", "2000000001"))
+	if got := portfolio.InvestServiceGetPortfolio.Positions; len(got) != 2 || got[0].Ticker != "AAPL" || got[0].Balance != 10 {
+		t.Errorf("unexpected positions %v", got)
+	}
+
+	var quote struct{}
+	err := c.Post(`mutation { investServiceGetQuote(in: {figi: "unknown"}) { quote { price } } }`, &quote)
+	if err == nil {
+		t.Error("expected error for unknown quote")
+	}
+}
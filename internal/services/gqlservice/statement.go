@@ -0,0 +1,43 @@
+package gqlservice
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+)
+
+// InvestServiceImportStatement imports broker statement, content is passed base64 encoded.
+func (r *mutationResolver) InvestServiceImportStatement(ctx context.Context, in *gqlmodels.ImportStatementRequestInput) (*gqlmodels.ImportStatementResponse, error) {
+	if in == nil {
+		in = &gqlmodels.ImportStatementRequestInput{}
+	}
+
+	content, err := base64.StdEncoding.DecodeString(stringValue(in.Content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: statement content must be base64 encoded: %s", invest.ErrInvalidArgument, err)
+	}
+
+	req := &pb.ImportStatementRequest{
+		Provider: stringValue(in.Provider),
+		Format:   stringValue(in.Format),
+		Content:  content,
+	}
+	importer, err := r.providerService.Importer(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	respPb, err := importer.Import(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, operations := int(respPb.Positions), int(respPb.Operations)
+	return &gqlmodels.ImportStatementResponse{
+		Accounts:   convertPbAccountsToGql(respPb.Accounts),
+		Positions:  &positions,
+		Operations: &operations,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package gqlservice
+
+import (
+	"context"
+	"encoding/base64"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/statement"
+)
+
+// InvestServiceGenerateStatement renders and stores periodic statement of account, content is base64 encoded.
+func (r *mutationResolver) InvestServiceGenerateStatement(ctx context.Context, in *gqlmodels.GenerateStatementRequestInput) (*gqlmodels.StatementResponse, error) {
+	if in == nil {
+		in = &gqlmodels.GenerateStatementRequestInput{}
+	}
+
+	respPb, err := statement.Generate(ctx, r.Provider(), r.storage, &pb.GenerateStatementRequest{
+		Account:       convertGqlAccountToPb(in.Account),
+		Period:        stringValue(in.Period),
+		Date:          stringValue(in.Date),
+		Format:        stringValue(in.Format),
+		CurrencyRates: convertGqlRatesToPb(in.CurrencyRates),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPbStatementContentToGql(respPb.Statement, respPb.Content), nil
+}
+
+func (r *mutationResolver) InvestServiceGetStatements(ctx context.Context, in *gqlmodels.StatementsRequestInput) (*gqlmodels.StatementsResponse, error) {
+	if in == nil {
+		in = &gqlmodels.StatementsRequestInput{}
+	}
+
+	respPb, err := statement.Statements(ctx, r.storage, &pb.StatementsRequest{Account: convertGqlAccountToPb(in.Account)})
+	if err != nil {
+		return nil, err
+	}
+
+	statements := make([]*gqlmodels.Statement, 0, len(respPb.Statements))
+	for _, st := range respPb.Statements {
+		statements = append(statements, convertPbStatementToGql(st))
+	}
+	return &gqlmodels.StatementsResponse{Statements: statements}, nil
+}
+
+// InvestServiceGetStatement returns stored statement, content is base64 encoded.
+func (r *mutationResolver) InvestServiceGetStatement(ctx context.Context, in *gqlmodels.StatementRequestInput) (*gqlmodels.StatementResponse, error) {
+	var id int64
+	if in != nil && in.ID != nil {
+		id = int64(*in.ID)
+	}
+
+	respPb, err := statement.Get(ctx, r.storage, &pb.StatementRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPbStatementContentToGql(respPb.Statement, respPb.Content), nil
+}
+
+func convertPbStatementContentToGql(st *pb.Statement, content []byte) *gqlmodels.StatementResponse {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	return &gqlmodels.StatementResponse{
+		Statement: convertPbStatementToGql(st),
+		Content:   &encoded,
+	}
+}
+
+func convertPbStatementToGql(st *pb.Statement) *gqlmodels.Statement {
+	id := int(st.Id)
+	return &gqlmodels.Statement{
+		ID:          &id,
+		Account:     convertPbAccountsToGql([]*pb.Account{st.Account})[0],
+		Period:      &st.Period,
+		From:        &st.From,
+		To:          &st.To,
+		Format:      &st.Format,
+		Filename:    &st.Filename,
+		ContentType: &st.ContentType,
+		CreatedAt:   &st.CreatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/tax"
+)
+
+func (r *mutationResolver) InvestServiceGetTaxReport(ctx context.Context, in *gqlmodels.TaxReportRequestInput) (*gqlmodels.TaxReportResponse, error) {
+	if in == nil {
+		in = &gqlmodels.TaxReportRequestInput{}
+	}
+	req := &pb.TaxReportRequest{
+		Account:       convertGqlAccountToPb(in.Account),
+		CurrencyRates: convertGqlRatesToPb(in.CurrencyRates),
+	}
+	if in.Year != nil {
+		req.Year = int32(*in.Year)
+	}
+
+	reportPb, err := tax.Estimate(ctx, r.Provider(), req)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodels.TaxReportResponse{
+		Report: convertPbTaxReportToGql(reportPb.Report),
+	}, nil
+}
+
+func convertPbTaxReportToGql(pbReport *pb.TaxReport) *gqlmodels.TaxReport {
+	year := int(pbReport.Year)
+	report := &gqlmodels.TaxReport{
+		Year:             &year,
+		RealizedGain:     &pbReport.RealizedGain,
+		RealizedLoss:     &pbReport.RealizedLoss,
+		ExemptGain:       &pbReport.ExemptGain,
+		CouponIncome:     &pbReport.CouponIncome,
+		DividendIncome:   &pbReport.DividendIncome,
+		ForeignTaxCredit: &pbReport.ForeignTaxCredit,
+		TaxBase:          &pbReport.TaxBase,
+		TaxEstimate:      &pbReport.TaxEstimate,
+		TaxWithheld:      &pbReport.TaxWithheld,
+		TaxDue:           &pbReport.TaxDue,
+	}
+	if pbReport.Account != nil {
+		report.Account = convertPbAccountsToGql([]*pb.Account{pbReport.Account})[0]
+	}
+	if iis := pbReport.Iis; iis != nil {
+		report.Iis = &gqlmodels.IisDeduction{
+			Contributions:  &iis.Contributions,
+			DeductionBase:  &iis.DeductionBase,
+			Deduction:      &iis.Deduction,
+			RemainingLimit: &iis.RemainingLimit,
+		}
+	}
+	return report
+}
+
+func convertGqlRatesToPb(in []*gqlmodels.CurrencyRateInput) []*pb.CurrencyRate {
+	rates := make([]*pb.CurrencyRate, 0, len(in))
+	for _, rate := range in {
+		pbRate := &pb.CurrencyRate{Currency: stringValue(rate.Currency)}
+		if rate.Rate != nil {
+			pbRate.Rate = *rate.Rate
+		}
+		rates = append(rates, pbRate)
+	}
+	return rates
+}
@@ -0,0 +1,23 @@
+package gqlservice
+
+import (
+	"context"
+	gqlmodels "goinvest/gen/gql/models"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/telegram"
+)
+
+func (r *mutationResolver) InvestServiceCreateTelegramLink(ctx context.Context, in *gqlmodels.TelegramLinkRequestInput) (*gqlmodels.TelegramLinkResponse, error) {
+	req := &pb.TelegramLinkRequest{}
+	if in != nil {
+		req.User = stringValue(in.User)
+	}
+	respPb, err := telegram.CreateLink(ctx, r.storage, req)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodels.TelegramLinkResponse{
+		Code:      &respPb.Code,
+		ExpiresAt: &respPb.ExpiresAt,
+	}, nil
+}
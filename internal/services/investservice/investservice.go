@@ -5,8 +5,19 @@ import (
 	"errors"
 	"go.uber.org/zap"
 	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/alert"
+	"goinvest/internal/allocation"
+	"goinvest/internal/consolidation"
+	"goinvest/internal/export"
 	"goinvest/internal/invest"
+	"goinvest/internal/metrics"
+	"goinvest/internal/notify"
+	"goinvest/internal/providers/manual"
+	"goinvest/internal/rebalance"
 	"goinvest/internal/services/providerservice"
+	"goinvest/internal/statement"
+	"goinvest/internal/tax"
+	"goinvest/internal/telegram"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -55,13 +66,129 @@ func (s *Service) GetPortfolio(ctx context.Context, req *pb.PortfolioRequest) (*
 	return s.Provider().Portfolio(ctx, req)
 }
 
-func (s *Service) Provider() invest.Provider {
-	provider, err := s.providerService.Provider(invest.ProviderTinkoff)
+func (s *Service) GetOperations(ctx context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+	return s.Provider().Operations(ctx, req)
+}
+
+func (s *Service) GetTaxReport(ctx context.Context, req *pb.TaxReportRequest) (*pb.TaxReportResponse, error) {
+	return tax.Estimate(ctx, s.Provider(), req)
+}
+
+func (s *Service) GetAllocation(ctx context.Context, req *pb.AllocationRequest) (*pb.AllocationResponse, error) {
+	return allocation.Allocation(ctx, s.Provider(), s.storage, req)
+}
+
+func (s *Service) SaveInstrument(ctx context.Context, req *pb.SaveInstrumentRequest) (*pb.SaveInstrumentResponse, error) {
+	return allocation.SaveInstrument(ctx, s.storage, req)
+}
+
+func (s *Service) GetQuote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	return s.Provider().Quote(ctx, req)
+}
+
+func (s *Service) SetTargetWeights(ctx context.Context, req *pb.SetTargetWeightsRequest) (*pb.SetTargetWeightsResponse, error) {
+	return rebalance.SetTargetWeights(ctx, s.storage, req)
+}
+
+func (s *Service) GetTargetWeights(ctx context.Context, req *pb.TargetWeightsRequest) (*pb.TargetWeightsResponse, error) {
+	return rebalance.TargetWeights(ctx, s.storage, req)
+}
+
+func (s *Service) Rebalance(ctx context.Context, req *pb.RebalanceRequest) (*pb.RebalanceResponse, error) {
+	return rebalance.Rebalance(ctx, s.Provider(), s.storage, req)
+}
+
+func (s *Service) GetConsolidatedPortfolio(ctx context.Context, _ *pb.ConsolidatedPortfolioRequest) (*pb.ConsolidatedPortfolioResponse, error) {
+	return consolidation.Portfolio(ctx, s.providerService.Providers())
+}
+
+func (s *Service) ImportStatement(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error) {
+	importer, err := s.providerService.Importer(req.Provider)
 	if err != nil {
-		s.logger.Error("cannon get provider", zap.Error(err))
-		return nil
+		return nil, err
 	}
-	return provider
+	return importer.Import(ctx, req)
+}
+
+func (s *Service) GetManualAccounts(ctx context.Context, req *pb.ManualAccountsRequest) (*pb.ManualAccountsResponse, error) {
+	return manual.Accounts(ctx, s.storage, req)
+}
+
+func (s *Service) SaveManualAccount(ctx context.Context, req *pb.SaveManualAccountRequest) (*pb.SaveManualAccountResponse, error) {
+	return manual.SaveAccount(ctx, s.storage, req)
+}
+
+func (s *Service) DeleteManualAccount(ctx context.Context, req *pb.DeleteManualAccountRequest) (*pb.DeleteManualAccountResponse, error) {
+	return manual.DeleteAccount(ctx, s.storage, req)
+}
+
+func (s *Service) GetManualPositions(ctx context.Context, req *pb.ManualPositionsRequest) (*pb.ManualPositionsResponse, error) {
+	return manual.Positions(ctx, s.storage, req)
+}
+
+func (s *Service) SaveManualPosition(ctx context.Context, req *pb.SaveManualPositionRequest) (*pb.SaveManualPositionResponse, error) {
+	return manual.SavePosition(ctx, s.storage, req)
+}
+
+func (s *Service) DeleteManualPosition(ctx context.Context, req *pb.DeleteManualPositionRequest) (*pb.DeleteManualPositionResponse, error) {
+	return manual.DeletePosition(ctx, s.storage, req)
+}
+
+func (s *Service) UpdateManualPrice(ctx context.Context, req *pb.UpdateManualPriceRequest) (*pb.UpdateManualPriceResponse, error) {
+	return manual.UpdatePrice(ctx, s.storage, req)
+}
+
+func (s *Service) ExportReport(ctx context.Context, req *pb.ExportReportRequest) (*pb.ExportReportResponse, error) {
+	return export.Report(ctx, s.Provider(), req)
+}
+
+func (s *Service) GenerateStatement(ctx context.Context, req *pb.GenerateStatementRequest) (*pb.GenerateStatementResponse, error) {
+	return statement.Generate(ctx, s.Provider(), s.storage, req)
+}
+
+func (s *Service) GetStatements(ctx context.Context, req *pb.StatementsRequest) (*pb.StatementsResponse, error) {
+	return statement.Statements(ctx, s.storage, req)
+}
+
+func (s *Service) GetStatement(ctx context.Context, req *pb.StatementRequest) (*pb.StatementResponse, error) {
+	return statement.Get(ctx, s.storage, req)
+}
+
+func (s *Service) GetAlertRules(ctx context.Context, req *pb.AlertRulesRequest) (*pb.AlertRulesResponse, error) {
+	return alert.Rules(ctx, s.storage, req)
+}
+
+func (s *Service) SaveAlertRule(ctx context.Context, req *pb.SaveAlertRuleRequest) (*pb.SaveAlertRuleResponse, error) {
+	return alert.SaveRule(ctx, s.storage, req)
+}
+
+func (s *Service) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	return alert.DeleteRule(ctx, s.storage, req)
+}
+
+func (s *Service) GetAlertEvents(ctx context.Context, req *pb.AlertEventsRequest) (*pb.AlertEventsResponse, error) {
+	return alert.Events(ctx, s.storage, req)
+}
+
+func (s *Service) GetNotificationChannels(ctx context.Context, req *pb.NotificationChannelsRequest) (*pb.NotificationChannelsResponse, error) {
+	return notify.Channels(ctx, s.storage, req)
+}
+
+func (s *Service) SaveNotificationChannel(ctx context.Context, req *pb.SaveNotificationChannelRequest) (*pb.SaveNotificationChannelResponse, error) {
+	return notify.SaveChannel(ctx, s.storage, req)
+}
+
+func (s *Service) DeleteNotificationChannel(ctx context.Context, req *pb.DeleteNotificationChannelRequest) (*pb.DeleteNotificationChannelResponse, error) {
+	return notify.DeleteChannel(ctx, s.storage, req)
+}
+
+func (s *Service) CreateTelegramLink(ctx context.Context, req *pb.TelegramLinkRequest) (*pb.TelegramLinkResponse, error) {
+	return telegram.CreateLink(ctx, s.storage, req)
+}
+
+// Provider returns provider which serves accounts of all enabled providers.
+func (s *Service) Provider() invest.Provider {
+	return s.providerService.Router()
 }
 
 // ValidationUnaryInterceptor validates incoming requests
@@ -79,7 +206,7 @@ func (s *Service) ValidationUnaryInterceptor(ctx context.Context, req interface{
 func (s *Service) ErrorUnaryInterceptor(
 	ctx context.Context,
 	req interface{},
-	_ *grpc.UnaryServerInfo,
+	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (resp interface{}, err error) {
 
 	resp, err = handler(ctx, req)
@@ -87,13 +214,18 @@ func (s *Service) ErrorUnaryInterceptor(
 		return
 	}
 
-	// errorsTotal.Inc()
+	metrics.ObserveAPIError(info.FullMethod, err)
 
 	if errors.Is(err, invest.ErrNotFound) {
 		err = status.Error(codes.NotFound, err.Error())
 		return
 	}
 
+	if errors.Is(err, invest.ErrInvalidArgument) {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+
 	err = status.Error(codes.Internal, err.Error())
 	return
 }
@@ -0,0 +1,105 @@
+package investservice
+
+import (
+	"context"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	_ "goinvest/internal/providers/fake"
+	"goinvest/internal/services/providerservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+type testStorage struct{ invest.Storage }
+
+func (testStorage) Instruments(context.Context, []string) (map[string]invest.Instrument, error) {
+	return map[string]invest.Instrument{"BBG004730N88": {Sector: "financial"}}, nil
+}
+
+type testCache struct{ invest.Cache }
+
+func newTestService(t *testing.T, options map[string]string) *Service {
+	t.Helper()
+
+	conf := &invest.ProvidersConfig{
+		List: []invest.ProviderConfig{{Name: "fake", Enabled: true, Options: options}},
+	}
+	providerService, err := providerservice.NewProviderService(conf, testStorage{}, testCache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := NewService(providerService, testStorage{}, testCache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service
+}
+
+func TestService(t *testing.T) {
+
+	service := newTestService(t, map[string]string{"fixture": "../../providers/fake/testdata/fixture.yaml"})
+	ctx := context.Background()
+
+	accounts, err := service.GetAccounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts.Accounts) != 2 || accounts.Accounts[0].Provider != "fake" {
+		t.Fatalf("unexpected accounts %v", accounts.Accounts)
+	}
+
+	portfolio, err := service.GetPortfolio(ctx, &pb.PortfolioRequest{Account: accounts.Accounts[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(portfolio.Positions) != 2 {
+		t.Errorf("unexpected portfolio %v", portfolio)
+	}
+
+	allocation, err := service.GetAllocation(ctx, &pb.AllocationRequest{
+		CurrencyRates: []*pb.CurrencyRate{{Currency: "USD", Rate: 75}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// AAPL 1500 USD, SBER 30000 RUB, cash 10000 RUB + 100 USD + 400000 RUB
+	if allocation.Total != 1500*75+30000+10000+100*75+400000 {
+		t.Errorf("unexpected allocation total %v", allocation.Total)
+	}
+
+	consolidated, err := service.GetConsolidatedPortfolio(ctx, &pb.ConsolidatedPortfolioRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(consolidated.Sources) != 2 || len(consolidated.Positions) != 2 {
+		t.Errorf("unexpected consolidated portfolio %v", consolidated)
+	}
+}
+
+func TestErrorUnaryInterceptor(t *testing.T) {
+
+	service := newTestService(t, map[string]string{"fixture": "../../providers/fake/testdata/fixture.yaml"})
+
+	tests := []struct {
+		name string
+		req  *pb.QuoteRequest
+		code codes.Code
+	}{
+		{"found", &pb.QuoteRequest{Figi: "BBG000B9XRY4"}, codes.OK},
+		{"not found", &pb.QuoteRequest{Figi: "unknown"}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ErrorUnaryInterceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return service.GetQuote(ctx, req.(*pb.QuoteRequest))
+				})
+			if code := status.Code(err); code != tt.code {
+				t.Errorf("expected code %s, got %s (%v)", tt.code, code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package providerservice
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"golang.org/x/time/rate"
+)
+
+// rpsOption is provider option limiting its calls per second, calls are not limited when it is zero.
+const rpsOption = "rps"
+
+// rateLimit returns limit and burst of limiter for the provider config.
+func rateLimit(conf invest.ProviderConfig) (rate.Limit, int, error) {
+
+	rps, err := conf.IntOption(rpsOption, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	if rps < 0 {
+		return 0, 0, fmt.Errorf("provider %s: option %s must not be negative", conf.Name, rpsOption)
+	}
+	if rps == 0 {
+		return rate.Inf, 0, nil
+	}
+	return rate.Limit(rps), rps, nil
+}
+
+// limitProvider wraps provider to wait for limiter before its calls.
+func limitProvider(name string, limiter *rate.Limiter, provider invest.Provider) invest.Provider {
+	return invest.WrapProvider(provider, &limitedProvider{name: name, limiter: limiter, provider: provider})
+}
+
+type limitedProvider struct {
+	name     string
+	limiter  *rate.Limiter
+	provider invest.Provider
+}
+
+// Unwrap returns the limited provider.
+func (p *limitedProvider) Unwrap() invest.Provider {
+	return p.provider
+}
+
+func (p *limitedProvider) wait(ctx context.Context) error {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("problem while waiting for rate limit of provider %s: %w", p.name, err)
+	}
+	return nil
+}
+
+func (p *limitedProvider) Portfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	if err := p.wait(ctx); err != nil {
+		return nil, err
+	}
+	return p.provider.Portfolio(ctx, req)
+}
+
+func (p *limitedProvider) Accounts(ctx context.Context, req *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	if err := p.wait(ctx); err != nil {
+		return nil, err
+	}
+	return p.provider.Accounts(ctx, req)
+}
+
+func (p *limitedProvider) Operations(ctx context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+	if err := p.wait(ctx); err != nil {
+		return nil, err
+	}
+	return p.provider.Operations(ctx, req)
+}
+
+func (p *limitedProvider) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	if err := p.wait(ctx); err != nil {
+		return nil, err
+	}
+	return p.provider.Quote(ctx, req)
+}
+
+func (p *limitedProvider) Import(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error) {
+	if err := p.wait(ctx); err != nil {
+		return nil, err
+	}
+	return p.provider.(invest.Importer).Import(ctx, req)
+}
@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"go.uber.org/zap"
 	"goinvest/internal/invest"
-	"goinvest/internal/providers/tinkoff"
+	"goinvest/internal/metrics"
+	"goinvest/internal/tracing"
+	"golang.org/x/time/rate"
+	"reflect"
+	"strings"
 )
 
 // ProviderService is responsible for the choice of appropriate provider.
@@ -14,6 +18,8 @@ type ProviderService struct {
 	providerStorage invest.Storage
 	cache           invest.Cache
 	providers       map[invest.ProviderID]invest.Provider
+	order           []invest.ProviderID
+	limiters        map[string]*rate.Limiter // by provider name, updated on reload of config
 	logger          *zap.Logger
 }
 
@@ -54,24 +60,52 @@ func NewProviderService(
 	return providerService, nil
 }
 
-// initProviders retrieves providers data from database, then constructs provider clients among with
-// its options.
+// initProviders constructs enabled providers from config by factories registered in invest provider registry.
+// Provider packages register themselves on import, see invest.RegisterProvider.
 func (ps *ProviderService) initProviders() error {
 
-	providersMap := make(map[invest.ProviderID]invest.Provider, 1)
+	enabled := ps.conf.Enabled()
+	providersMap := make(map[invest.ProviderID]invest.Provider, len(enabled))
+	order := make([]invest.ProviderID, 0, len(enabled))
+	limiters := make(map[string]*rate.Limiter, len(enabled))
 
-	options := &tinkoff.ProviderOptions{
-		Token:        ps.conf.Tinkoff.Token,
-		SandboxToken: ps.conf.Tinkoff.TokenSandbox,
-	}
-	tinkoffProvider, err := tinkoff.NewTinkoff(options, ps.cache, ps.logger)
-	if err != nil {
-		return fmt.Errorf("problem with tinkoffProvider init: %w", err)
+	deps := invest.ProviderDependencies{
+		Storage: ps.providerStorage,
+		Cache:   ps.cache,
+		Logger:  ps.logger,
 	}
 
-	providersMap[invest.ProviderTinkoff] = tinkoffProvider
+	for _, conf := range enabled {
+		id, factory, found := invest.RegisteredProvider(conf.Name)
+		if !found {
+			return fmt.Errorf("provider %q is not registered, registered providers: %s",
+				conf.Name, strings.Join(invest.RegisteredProviders(), ", "))
+		}
+		if _, duplicated := providersMap[id]; duplicated {
+			return fmt.Errorf("provider %q is configured twice", conf.Name)
+		}
+
+		provider, err := factory(conf, deps)
+		if err != nil {
+			return fmt.Errorf("problem with %s provider init: %w", conf.Name, err)
+		}
+
+		limit, burst, err := rateLimit(conf)
+		if err != nil {
+			return err
+		}
+		limiters[conf.Name] = rate.NewLimiter(limit, burst)
+
+		// spans include waiting for rate limit, metrics observe provider calls only
+		providersMap[id] = tracing.TraceProvider(conf.Name,
+			limitProvider(conf.Name, limiters[conf.Name], metrics.InstrumentProvider(conf.Name, provider)))
+		order = append(order, id)
+		ps.logger.Info("provider initialized", zap.String("provider", conf.Name))
+	}
 
 	ps.providers = providersMap
+	ps.order = order
+	ps.limiters = limiters
 
 	return nil
 }
@@ -83,3 +117,107 @@ func (ps *ProviderService) Provider(providerID invest.ProviderID) (invest.Provid
 	}
 	return nil, errors.New("provider was not found")
 }
+
+// ProviderByName is a getter which chooses provider by its registered name.
+func (ps *ProviderService) ProviderByName(name string) (invest.Provider, error) {
+	id, _, found := invest.RegisteredProvider(name)
+	if !found {
+		return nil, fmt.Errorf("%w: provider %q is not registered", invest.ErrInvalidArgument, name)
+	}
+	if provider, found := ps.providers[id]; found {
+		return provider, nil
+	}
+	return nil, fmt.Errorf("%w: provider %q is not enabled", invest.ErrInvalidArgument, name)
+}
+
+// Importer returns provider by name which imports broker statements,
+// empty name chooses the only enabled importer.
+func (ps *ProviderService) Importer(name string) (invest.Importer, error) {
+
+	if name != "" {
+		provider, err := ps.ProviderByName(name)
+		if err != nil {
+			return nil, err
+		}
+		importer, ok := provider.(invest.Importer)
+		if !ok {
+			return nil, fmt.Errorf("%w: provider %q does not import statements", invest.ErrInvalidArgument, name)
+		}
+		return importer, nil
+	}
+
+	var importers []invest.Importer
+	for _, id := range ps.order {
+		if importer, ok := ps.providers[id].(invest.Importer); ok {
+			importers = append(importers, importer)
+		}
+	}
+	switch len(importers) {
+	case 0:
+		return nil, fmt.Errorf("%w: no enabled provider imports statements", invest.ErrInvalidArgument)
+	case 1:
+		return importers[0], nil
+	default:
+		return nil, fmt.Errorf("%w: several providers import statements, provider must be specified", invest.ErrInvalidArgument)
+	}
+}
+
+// Providers returns all initialized providers by their ids.
+func (ps *ProviderService) Providers() map[invest.ProviderID]invest.Provider {
+	providers := make(map[invest.ProviderID]invest.Provider, len(ps.providers))
+	for id, provider := range ps.providers {
+		providers[id] = provider
+	}
+	return providers
+}
+
+// UpdateRateLimits applies rps options of running providers from reloaded config without reconstruction of
+// providers, so connections are kept. Other changes of providers take effect after restart.
+func (ps *ProviderService) UpdateRateLimits(conf *invest.ProvidersConfig) {
+
+	initial := make(map[string]invest.ProviderConfig)
+	for _, c := range ps.conf.Enabled() {
+		initial[c.Name] = c
+	}
+	enabled := conf.Enabled()
+	running := make(map[string]bool, len(enabled))
+	for _, c := range enabled {
+		running[c.Name] = true
+		limiter, found := ps.limiters[c.Name]
+		if !found {
+			ps.logger.Warn("provider is enabled in config, it starts after restart", zap.String("provider", c.Name))
+			continue
+		}
+		if !reflect.DeepEqual(withoutRPS(initial[c.Name]), withoutRPS(c)) {
+			ps.logger.Warn("provider settings other than rps take effect after restart", zap.String("provider", c.Name))
+		}
+		limit, burst, err := rateLimit(c)
+		if err != nil {
+			ps.logger.Error("problem while updating provider rate limit", zap.String("provider", c.Name), zap.Error(err))
+			continue
+		}
+		if limiter.Limit() != limit || limiter.Burst() != burst {
+			limiter.SetLimit(limit)
+			limiter.SetBurst(burst)
+			ps.logger.Info("provider rate limit updated", zap.String("provider", c.Name), zap.Float64("rps", float64(limit)))
+		}
+	}
+	for name := range ps.limiters {
+		if !running[name] {
+			ps.logger.Warn("provider is disabled in config, it stops after restart", zap.String("provider", name))
+		}
+	}
+}
+
+// withoutRPS returns copy of provider config without rps option.
+func withoutRPS(conf invest.ProviderConfig) invest.ProviderConfig {
+
+	options := make(map[string]string, len(conf.Options))
+	for k, v := range conf.Options {
+		if k != rpsOption {
+			options[k] = v
+		}
+	}
+	conf.Options = options
+	return conf
+}
@@ -0,0 +1,170 @@
+package providerservice
+
+import (
+	"context"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"golang.org/x/time/rate"
+	"testing"
+	"time"
+)
+
+const (
+	providerTest  invest.ProviderID = 1000
+	providerOther invest.ProviderID = 1001
+)
+
+type testProvider struct {
+	invest.Provider
+	conf invest.ProviderConfig
+}
+
+func (p *testProvider) Accounts(context.Context, *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	return &pb.AccountsResponse{Accounts: []*pb.Account{{AccountId: p.conf.Name}}}, nil
+}
+
+func (p *testProvider) Portfolio(context.Context, *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	return &pb.PortfolioResponse{Positions: []*pb.Position{{Figi: p.conf.Name}}}, nil
+}
+
+type testStorage struct{ invest.Storage }
+
+type testCache struct{ invest.Cache }
+
+func init() {
+	factory := func(conf invest.ProviderConfig, deps invest.ProviderDependencies) (invest.Provider, error) {
+		return &testProvider{conf: conf}, nil
+	}
+	invest.RegisterProvider("test", providerTest, factory)
+	invest.RegisterProvider("other", providerOther, factory)
+}
+
+func TestNewProviderService(t *testing.T) {
+
+	conf := &invest.ProvidersConfig{
+		List: []invest.ProviderConfig{
+			{Name: "test", Enabled: true, Credentials: map[string]string{"token": "secret"}},
+			{Name: "missing", Enabled: false},
+		},
+	}
+
+	service, err := NewProviderService(conf, testStorage{}, testCache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := service.Provider(providerTest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// providers are instrumented with metrics and traces
+	for {
+		wrapper, ok := provider.(interface{ Unwrap() invest.Provider })
+		if !ok {
+			break
+		}
+		provider = wrapper.Unwrap()
+	}
+	constructed := provider.(*testProvider)
+	if constructed.conf.Credentials["token"] != "secret" {
+		t.Errorf("provider is constructed with unexpected config %v", constructed.conf)
+	}
+	if providerTest.String() != "test" {
+		t.Errorf("unexpected provider name %s", providerTest)
+	}
+	if len(service.Providers()) != 1 {
+		t.Errorf("unexpected providers %v", service.Providers())
+	}
+}
+
+func TestNewProviderServiceNotRegistered(t *testing.T) {
+
+	conf := &invest.ProvidersConfig{
+		List: []invest.ProviderConfig{{Name: "missing", Enabled: true}},
+	}
+
+	if _, err := NewProviderService(conf, testStorage{}, testCache{}, zap.NewNop()); err == nil {
+		t.Error("expected error for not registered provider")
+	}
+}
+
+func TestRouter(t *testing.T) {
+
+	conf := &invest.ProvidersConfig{
+		List: []invest.ProviderConfig{{Name: "test", Enabled: true}, {Name: "other", Enabled: true}},
+	}
+	service, err := NewProviderService(conf, testStorage{}, testCache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := service.Router()
+	ctx := context.Background()
+
+	accounts, err := router.Accounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts.Accounts) != 2 || accounts.Accounts[0].Provider != "test" || accounts.Accounts[1].Provider != "other" {
+		t.Errorf("unexpected accounts %v", accounts.Accounts)
+	}
+
+	for provider, figi := range map[string]string{"": "test", "test": "test", "other": "other"} {
+		portfolio, err := router.Portfolio(ctx, &pb.PortfolioRequest{Account: &pb.Account{Provider: provider}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if portfolio.Positions[0].Figi != figi {
+			t.Errorf("portfolio of provider %q is routed to %s", provider, portfolio.Positions[0].Figi)
+		}
+	}
+
+	if _, err := router.Portfolio(ctx, &pb.PortfolioRequest{Account: &pb.Account{Provider: "missing"}}); err == nil {
+		t.Error("expected error for unknown provider")
+	}
+}
+
+func TestUpdateRateLimits(t *testing.T) {
+
+	conf := &invest.ProvidersConfig{
+		List: []invest.ProviderConfig{{Name: "test", Enabled: true, Options: map[string]string{"rps": "1"}}},
+	}
+	service, err := NewProviderService(conf, testStorage{}, testCache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := service.Provider(providerTest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the second call within a second exceeds the limit, it fails when deadline comes earlier
+	calls := func() (failed int) {
+		for i := 0; i < 2; i++ {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			if _, err := provider.Accounts(ctx, &pb.AccountsRequest{}); err != nil {
+				failed++
+			}
+			cancel()
+		}
+		return failed
+	}
+	if failed := calls(); failed != 1 {
+		t.Errorf("%d of calls failed with limit, want 1", failed)
+	}
+
+	conf.List[0].Options["rps"] = "0"
+	service.UpdateRateLimits(conf)
+	if failed := calls(); failed != 0 {
+		t.Errorf("%d of calls failed without limit", failed)
+	}
+	if limiter := service.limiters["test"]; limiter.Limit() != rate.Inf {
+		t.Errorf("limit = %v", limiter.Limit())
+	}
+
+	conf.List[0].Options["rps"] = "5"
+	service.UpdateRateLimits(conf)
+	if limiter := service.limiters["test"]; limiter.Limit() != 5 || limiter.Burst() != 5 {
+		t.Errorf("limit = %v, burst = %d", limiter.Limit(), limiter.Burst())
+	}
+}
@@ -0,0 +1,87 @@
+package providerservice
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"goinvest/internal/tracing"
+)
+
+// router is a provider which merges accounts of all enabled providers and routes account requests
+// to the provider of account. Requests of accounts without provider go to the first configured provider.
+type router struct {
+	ps *ProviderService
+}
+
+// Router returns provider which serves accounts of all enabled providers.
+func (ps *ProviderService) Router() invest.Provider {
+	return router{ps: ps}
+}
+
+// Accounts returns accounts of all providers marked by provider name. Failed providers are logged and skipped,
+// error is returned only when every provider failed.
+func (r router) Accounts(ctx context.Context, req *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+
+	var (
+		resp    = &pb.AccountsResponse{}
+		lastErr error
+	)
+	for _, id := range r.ps.order {
+		accounts, err := r.ps.providers[id].Accounts(ctx, req)
+		if err != nil {
+			tracing.Logger(ctx, r.ps.logger).Warn("cannot load provider accounts", zap.Stringer("provider", id), zap.Error(err))
+			lastErr = err
+			continue
+		}
+		for _, account := range accounts.Accounts {
+			account.Provider = id.String()
+			resp.Accounts = append(resp.Accounts, account)
+		}
+	}
+
+	if len(resp.Accounts) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return resp, nil
+}
+
+func (r router) Portfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	provider, err := r.provider(req.Account)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Portfolio(ctx, req)
+}
+
+func (r router) Operations(ctx context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+	provider, err := r.provider(req.Account)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Operations(ctx, req)
+}
+
+// Quote asks providers in configured order until one of them knows the instrument.
+func (r router) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+
+	err := errors.New("no provider is enabled")
+	for _, id := range r.ps.order {
+		var quote *pb.QuoteResponse
+		if quote, err = r.ps.providers[id].Quote(ctx, req); err == nil {
+			return quote, nil
+		}
+	}
+	return nil, err
+}
+
+func (r router) provider(account *pb.Account) (invest.Provider, error) {
+	if name := account.GetProvider(); name != "" {
+		return r.ps.ProviderByName(name)
+	}
+	if len(r.ps.order) == 0 {
+		return nil, errors.New("no provider is enabled")
+	}
+	return r.ps.providers[r.ps.order[0]], nil
+}
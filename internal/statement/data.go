@@ -0,0 +1,229 @@
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/allocation"
+	"goinvest/internal/invest"
+	"math"
+	"sort"
+	"time"
+)
+
+// Data is everything a statement shows, holdings are taken at generation time
+// while activity and income cover the statement period.
+type Data struct {
+	Account     *pb.Account
+	Period      string
+	Label       string
+	From        time.Time
+	To          time.Time
+	GeneratedAt time.Time
+
+	Holdings []Holding
+	Cash     []*pb.CurrencyBalance
+	// Allocation is nil when it cannot be calculated, AllocationNote explains why.
+	Allocation     *pb.AllocationResponse
+	AllocationNote string
+	Activity       []Activity
+	Income         []Income
+}
+
+// Holding is a position of account.
+type Holding struct {
+	Figi           string
+	Ticker         string
+	Name           string
+	InstrumentType string
+	Currency       string
+	Balance        float64
+	AveragePrice   float64
+	Price          float64
+	Value          float64
+	Yield          float64
+	YieldPercent   float64
+}
+
+// Activity sums up performance and cash flows of account in a single currency.
+type Activity struct {
+	Currency string
+	// Value is current value of holdings and cash, Yield is unrealized yield of holdings.
+	Value float64
+	Yield float64
+
+	Deposits    float64
+	Withdrawals float64
+	Purchases   float64
+	Sales       float64
+	Income      float64
+	Commissions float64
+	Taxes       float64
+}
+
+// NetFlow is deposits less withdrawals.
+func (a Activity) NetFlow() float64 {
+	return a.Deposits - a.Withdrawals
+}
+
+// Income is dividends and coupons of instrument received in period.
+type Income struct {
+	Figi      string
+	Ticker    string
+	Currency  string
+	Dividends float64
+	Coupons   float64
+	Tax       float64
+}
+
+// Net is income after taxes withheld.
+func (i Income) Net() float64 {
+	return i.Dividends + i.Coupons - i.Tax
+}
+
+// Collect loads portfolio and period operations of account and prepares statement data.
+// Allocation is calculated in roubles, so it is skipped with a note when a rate is missing.
+func Collect(ctx context.Context, provider invest.Provider, storage invest.InstrumentStorage, account *pb.Account,
+	period string, from, to time.Time, rates invest.Rates) (*Data, error) {
+
+	portfolio, err := provider.Portfolio(ctx, &pb.PortfolioRequest{Account: account})
+	if err != nil {
+		return nil, fmt.Errorf("load portfolio: %w", err)
+	}
+	operations, err := provider.Operations(ctx, &pb.OperationsRequest{
+		Account: account,
+		From:    from.Format(time.RFC3339),
+		To:      to.Add(-time.Second).Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load operations: %w", err)
+	}
+
+	data := &Data{
+		Account:     account,
+		Period:      period,
+		Label:       periodLabel(period, from),
+		From:        from,
+		To:          to,
+		GeneratedAt: time.Now().UTC().Truncate(time.Second),
+		Cash:        portfolio.Currencies,
+	}
+
+	var (
+		activities = make(map[string]*Activity)
+		activity   = func(currency string) *Activity {
+			a, found := activities[currency]
+			if !found {
+				a = &Activity{Currency: currency}
+				activities[currency] = a
+			}
+			return a
+		}
+		tickers = make(map[string]string)
+		figis   []string
+	)
+
+	for _, position := range portfolio.Positions {
+		// currency positions are accounted by portfolio currencies
+		if position.InstrumentType == invest.InstrumentTypeCurrency {
+			continue
+		}
+		value, currency := invest.PositionValue(position)
+		price, _ := invest.PositionPrice(position)
+		holding := Holding{
+			Figi:           position.Figi,
+			Ticker:         position.Ticker,
+			Name:           position.Name,
+			InstrumentType: position.InstrumentType,
+			Currency:       currency,
+			Balance:        position.Balance,
+			AveragePrice:   position.AveragePositionPrice.GetValue(),
+			Price:          price,
+			Value:          value,
+			Yield:          position.ExpectedYield.GetValue(),
+		}
+		if cost := holding.AveragePrice * holding.Balance; cost != 0 {
+			holding.YieldPercent = holding.Yield / cost * 100
+		}
+		data.Holdings = append(data.Holdings, holding)
+		tickers[position.Figi] = position.Ticker
+		figis = append(figis, position.Figi)
+
+		a := activity(currency)
+		a.Value += value
+		a.Yield += holding.Yield
+	}
+	for _, balance := range portfolio.Currencies {
+		activity(balance.Currency).Value += balance.Balance
+	}
+
+	var (
+		incomes = make(map[[2]string]*Income)
+		income  = func(figi, currency string) *Income {
+			key := [2]string{figi, currency}
+			i, found := incomes[key]
+			if !found {
+				i = &Income{Figi: figi, Ticker: tickers[figi], Currency: currency}
+				incomes[key] = i
+			}
+			return i
+		}
+	)
+	for _, op := range operations.Operations {
+		a := activity(op.Currency)
+		switch op.OperationType {
+		case pb.OperationType_OPERATION_TYPE_PAY_IN:
+			a.Deposits += op.Payment
+		case pb.OperationType_OPERATION_TYPE_PAY_OUT:
+			a.Withdrawals -= op.Payment
+		case pb.OperationType_OPERATION_TYPE_BUY:
+			a.Purchases -= op.Payment
+		case pb.OperationType_OPERATION_TYPE_SELL, pb.OperationType_OPERATION_TYPE_REPAYMENT:
+			a.Sales += op.Payment
+		case pb.OperationType_OPERATION_TYPE_DIVIDEND:
+			a.Income += op.Payment
+			income(op.Figi, op.Currency).Dividends += op.Payment
+		case pb.OperationType_OPERATION_TYPE_COUPON:
+			a.Income += op.Payment
+			income(op.Figi, op.Currency).Coupons += op.Payment
+		case pb.OperationType_OPERATION_TYPE_COMMISSION:
+			a.Commissions -= op.Payment
+		case pb.OperationType_OPERATION_TYPE_TAX, pb.OperationType_OPERATION_TYPE_TAX_BACK:
+			a.Taxes -= op.Payment
+		case pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND, pb.OperationType_OPERATION_TYPE_TAX_COUPON:
+			a.Taxes -= op.Payment
+			income(op.Figi, op.Currency).Tax -= op.Payment
+		}
+	}
+
+	for _, a := range activities {
+		data.Activity = append(data.Activity, *a)
+	}
+	sort.Slice(data.Activity, func(i, j int) bool { return data.Activity[i].Currency < data.Activity[j].Currency })
+	for _, i := range incomes {
+		data.Income = append(data.Income, *i)
+	}
+	sort.Slice(data.Income, func(i, j int) bool {
+		if data.Income[i].Figi != data.Income[j].Figi {
+			return data.Income[i].Figi < data.Income[j].Figi
+		}
+		return data.Income[i].Currency < data.Income[j].Currency
+	})
+	sort.SliceStable(data.Holdings, func(i, j int) bool {
+		return math.Abs(data.Holdings[i].Value) > math.Abs(data.Holdings[j].Value)
+	})
+
+	instruments, err := storage.Instruments(ctx, figis)
+	if err != nil {
+		return nil, fmt.Errorf("load instruments: %w", err)
+	}
+	data.Allocation, err = allocation.Analyze([]*pb.PortfolioResponse{portfolio}, instruments, rates)
+	if errors.Is(err, invest.ErrInvalidArgument) {
+		data.AllocationNote = err.Error()
+	} else if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
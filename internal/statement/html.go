@@ -0,0 +1,70 @@
+package statement
+
+import (
+	"bytes"
+	_ "embed"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"html/template"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed statement.html
+var htmlSource string
+
+var htmlTemplate = template.Must(template.New("statement").Funcs(template.FuncMap{
+	"money":   formatMoney,
+	"percent": formatPercent,
+	"weight":  func(w float64) string { return formatPercent(w * 100) },
+	"date":    func(t time.Time) string { return t.Format("02.01.2006") },
+	"groups":  allocationGroups,
+}).Parse(htmlSource))
+
+// RenderHTML renders statement as a standalone HTML page.
+func RenderHTML(data *Data) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// formatMoney writes value with two decimals and spaces between thousands, e.g. 15 000.25.
+func formatMoney(value float64) string {
+	formatted := strconv.FormatFloat(math.Abs(value), 'f', 2, 64)
+	integer, fraction := formatted[:len(formatted)-3], formatted[len(formatted)-3:]
+
+	var b strings.Builder
+	if value < 0 && formatted != "0.00" {
+		b.WriteByte('-')
+	}
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(digit)
+	}
+	b.WriteString(fraction)
+	return b.String()
+}
+
+func formatPercent(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64) + "%"
+}
+
+// allocationGroup is a titled breakdown of allocation.
+type allocationGroup struct {
+	Title   string
+	Weights []*pb.AllocationWeight
+}
+
+func allocationGroups(a *pb.AllocationResponse) []allocationGroup {
+	return []allocationGroup{
+		{Title: "By type", Weights: a.ByInstrumentType},
+		{Title: "By currency", Weights: a.ByCurrency},
+		{Title: "By sector", Weights: a.BySector},
+		{Title: "By country", Weights: a.ByCountry},
+	}
+}
@@ -0,0 +1,211 @@
+package statement
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"github.com/jung-kurt/gofpdf"
+	"strconv"
+	"strings"
+)
+
+//go:embed fonts/DejaVuSansCondensed.ttf
+var pdfFont []byte
+
+const (
+	pdfFontFamily = "DejaVu"
+	pdfLineHeight = 6
+	// pdfWidth is printable width of A4 page with 10 mm margins.
+	pdfWidth = 277
+)
+
+// pdfColumn is a table column, width is a share of printable width.
+type pdfColumn struct {
+	title   string
+	width   float64
+	numeric bool
+}
+
+// RenderPDF renders statement as A4 landscape document.
+func RenderPDF(data *Data) ([]byte, error) {
+
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(10, 10, 10)
+	pdf.SetAutoPageBreak(true, 12)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "", pdfFont)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-10)
+		pdf.SetFont(pdfFontFamily, "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		pdf.CellFormat(0, 5, fmt.Sprintf("Account %s, %s, page %d of {nb}", data.Account.AccountId, data.Label, pdf.PageNo()),
+			"", 0, "R", false, 0, "")
+	})
+	pdf.AddPage()
+
+	pdf.SetFont(pdfFontFamily, "", 18)
+	pdf.CellFormat(0, 10, "Portfolio statement, "+data.Label, "", 1, "L", false, 0, "")
+	pdf.SetFont(pdfFontFamily, "", 9)
+	pdf.SetTextColor(100, 100, 100)
+	account := data.Account.AccountId
+	if data.Account.Provider != "" {
+		account += " (" + data.Account.Provider + ")"
+	}
+	pdf.CellFormat(0, 5, fmt.Sprintf("Account %s, period %s – %s (exclusive), generated %s", account,
+		data.From.Format("02.01.2006"), data.To.Format("02.01.2006"), data.GeneratedAt.Format("02.01.2006 15:04 MST")),
+		"", 1, "L", false, 0, "")
+	pdf.SetTextColor(0, 0, 0)
+
+	pdfHeading(pdf, "Performance and activity")
+	if len(data.Activity) == 0 {
+		pdfNote(pdf, "No activity.")
+	} else {
+		columns := []pdfColumn{{"Currency", 1, false}, {"Value", 1.4, true}, {"Unrealized yield", 1.4, true},
+			{"Deposits", 1.2, true}, {"Withdrawals", 1.2, true}, {"Purchases", 1.2, true}, {"Sales", 1.2, true},
+			{"Income", 1.2, true}, {"Commissions", 1.2, true}, {"Taxes", 1.2, true}}
+		rows := make([][]string, 0, len(data.Activity))
+		for _, a := range data.Activity {
+			rows = append(rows, []string{a.Currency, formatMoney(a.Value), formatMoney(a.Yield), formatMoney(a.Deposits),
+				formatMoney(a.Withdrawals), formatMoney(a.Purchases), formatMoney(a.Sales), formatMoney(a.Income),
+				formatMoney(a.Commissions), formatMoney(a.Taxes)})
+		}
+		pdfTable(pdf, columns, rows)
+	}
+
+	pdfHeading(pdf, "Holdings")
+	if len(data.Holdings) == 0 {
+		pdfNote(pdf, "No holdings.")
+	} else {
+		columns := []pdfColumn{{"Ticker", 1, false}, {"Name", 2.6, false}, {"Type", 0.9, false}, {"Balance", 1, true},
+			{"Average price", 1.1, true}, {"Price", 1.1, true}, {"Value", 1.3, true}, {"Yield", 1.2, true},
+			{"Yield, %", 0.9, true}, {"Currency", 0.8, false}}
+		rows := make([][]string, 0, len(data.Holdings))
+		for _, h := range data.Holdings {
+			ticker := h.Ticker
+			if ticker == "" {
+				ticker = h.Figi
+			}
+			rows = append(rows, []string{ticker, h.Name, h.InstrumentType, strconv.FormatFloat(h.Balance, 'f', -1, 64),
+				formatMoney(h.AveragePrice), formatMoney(h.Price), formatMoney(h.Value), formatMoney(h.Yield),
+				formatPercent(h.YieldPercent), h.Currency})
+		}
+		pdfTable(pdf, columns, rows)
+	}
+	if len(data.Cash) > 0 {
+		cash := make([]string, 0, len(data.Cash))
+		for _, c := range data.Cash {
+			cash = append(cash, formatMoney(c.Balance)+" "+c.Currency)
+		}
+		pdfNote(pdf, "Cash: "+strings.Join(cash, ", "))
+	}
+
+	pdfHeading(pdf, "Allocation")
+	if data.Allocation == nil {
+		pdfNote(pdf, "Allocation is not available: "+data.AllocationNote+".")
+	} else {
+		pdfNote(pdf, "Total "+formatMoney(data.Allocation.Total)+" RUB")
+		for _, group := range allocationGroups(data.Allocation) {
+			rows := make([][]string, 0, len(group.Weights))
+			for _, w := range group.Weights {
+				rows = append(rows, []string{w.Key, formatMoney(w.Value), formatPercent(w.Weight * 100)})
+			}
+			pdfTable(pdf, []pdfColumn{{group.Title, 2, false}, {"Value, RUB", 1, true}, {"Weight", 0.7, true}}, rows)
+			pdf.Ln(2)
+		}
+	}
+
+	pdfHeading(pdf, "Income")
+	if len(data.Income) == 0 {
+		pdfNote(pdf, "No income in period.")
+	} else {
+		columns := []pdfColumn{{"Instrument", 1.5, false}, {"Currency", 1, false}, {"Dividends", 1.2, true},
+			{"Coupons", 1.2, true}, {"Tax withheld", 1.2, true}, {"Net", 1.2, true}}
+		rows := make([][]string, 0, len(data.Income))
+		for _, i := range data.Income {
+			instrument := i.Ticker
+			if instrument == "" {
+				instrument = i.Figi
+			}
+			rows = append(rows, []string{instrument, i.Currency, formatMoney(i.Dividends), formatMoney(i.Coupons),
+				formatMoney(i.Tax), formatMoney(i.Net())})
+		}
+		pdfTable(pdf, columns, rows)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func pdfHeading(pdf *gofpdf.Fpdf, title string) {
+	pdf.Ln(4)
+	pdf.SetFont(pdfFontFamily, "", 13)
+	pdf.CellFormat(0, 8, title, "B", 1, "L", false, 0, "")
+	pdf.Ln(1)
+}
+
+func pdfNote(pdf *gofpdf.Fpdf, text string) {
+	pdf.SetFont(pdfFontFamily, "", 9)
+	pdf.MultiCell(0, pdfLineHeight, text, "", "L", false)
+}
+
+// pdfTable draws table with header repeated on every page, column widths are scaled to fit the page
+// when they exceed it and long values are truncated to column width.
+func pdfTable(pdf *gofpdf.Fpdf, columns []pdfColumn, rows [][]string) {
+
+	var total float64
+	for _, c := range columns {
+		total += c.width
+	}
+	// narrow tables are not stretched to the page width
+	unit := pdfWidth / total
+	if len(columns) <= 3 {
+		unit = pdfWidth / 10
+	}
+
+	header := func() {
+		pdf.SetFont(pdfFontFamily, "", 8)
+		pdf.SetFillColor(235, 237, 240)
+		for _, c := range columns {
+			align := "L"
+			if c.numeric {
+				align = "R"
+			}
+			pdf.CellFormat(c.width*unit, pdfLineHeight, c.title, "", 0, align, true, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	header()
+	_, pageHeight := pdf.GetPageSize()
+	_, _, _, bottom := pdf.GetMargins()
+	for _, row := range rows {
+		if pdf.GetY()+pdfLineHeight > pageHeight-bottom-12 {
+			pdf.AddPage()
+			header()
+		}
+		pdf.SetFont(pdfFontFamily, "", 8)
+		for i, c := range columns {
+			align := "L"
+			if c.numeric {
+				align = "R"
+			}
+			pdf.CellFormat(c.width*unit, pdfLineHeight, pdfFit(pdf, row[i], c.width*unit-2), "B", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+}
+
+// pdfFit truncates text to fit width with ellipsis.
+func pdfFit(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"…") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
@@ -0,0 +1,165 @@
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// defaultInterval is how often scheduler checks for completed periods without statements.
+const defaultInterval = time.Hour
+
+// Config configures scheduled generation of statements.
+type Config struct {
+	Enabled bool `yaml:"enabled"`
+	// Period of statements, month or quarter.
+	Period string `yaml:"period" validate:"omitempty,oneof=month quarter"`
+	// Format of statements, html or pdf.
+	Format string `yaml:"format" validate:"omitempty,oneof=html pdf"`
+	// Interval between checks for completed periods, an hour by default.
+	Interval time.Duration `yaml:"interval" validate:"gte=0"`
+	// CurrencyRates are rouble rates of currencies used to calculate allocation.
+	CurrencyRates map[string]float64 `yaml:"currencyRates"`
+}
+
+// Scheduler generates statements of every provider account for the last completed period
+// and stores them, accounts which already have the statement are skipped.
+type Scheduler struct {
+	conf     Config
+	provider invest.Provider
+	storage  invest.Storage
+	notifier invest.Notifier
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+// NewScheduler creates scheduler, generated statements are announced with notifier unless it is nil.
+func NewScheduler(conf Config, provider invest.Provider, storage invest.Storage, notifier invest.Notifier,
+	logger *zap.Logger) (*Scheduler, error) {
+
+	if provider == nil {
+		return nil, errors.New("provider provided to statement scheduler is nil")
+	}
+	if storage == nil {
+		return nil, errors.New("storage provided to statement scheduler is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger provided to statement scheduler is nil")
+	}
+
+	if conf.Period == "" {
+		conf.Period = PeriodMonth
+	}
+	if conf.Format == "" {
+		conf.Format = FormatPDF
+	}
+	if conf.Interval <= 0 {
+		conf.Interval = defaultInterval
+	}
+	if _, _, err := Bounds(conf.Period, time.Now()); err != nil {
+		return nil, fmt.Errorf("statement scheduler: %w", err)
+	}
+	if _, found := contentTypes[conf.Format]; !found {
+		return nil, fmt.Errorf("statement scheduler: unknown format %q", conf.Format)
+	}
+
+	return &Scheduler{
+		conf:     conf,
+		provider: provider,
+		storage:  storage,
+		notifier: notifier,
+		logger:   logger.With(zap.String("service", "statements")),
+		now:      time.Now,
+	}, nil
+}
+
+// Run generates missing statements immediately and then every interval until context is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+
+	ticker := time.NewTicker(s.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RunOnce(ctx); err != nil {
+			s.logger.Error("problem while generating scheduled statements", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce generates missing statements of the last completed period, failure of an account
+// is logged and does not stop others.
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+
+	from, to, err := LastCompleted(s.conf.Period, s.now())
+	if err != nil {
+		return err
+	}
+
+	accounts, err := s.provider.Accounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		return fmt.Errorf("load accounts: %w", err)
+	}
+
+	rates := invest.RatesFromConfig(s.conf.CurrencyRates)
+
+	for _, account := range accounts.Accounts {
+		logger := s.logger.With(zap.String("provider", account.Provider), zap.String("account", account.AccountId))
+
+		exists, err := s.exists(ctx, account, from)
+		if err != nil {
+			logger.Error("problem while checking stored statements", zap.Error(err))
+			continue
+		}
+		if exists {
+			continue
+		}
+
+		st, err := Render(ctx, s.provider, s.storage, account, s.conf.Period, from, to, s.conf.Format, rates)
+		if err != nil {
+			logger.Error("problem while rendering statement", zap.Error(err))
+			continue
+		}
+		if err := s.storage.SaveStatement(ctx, st); err != nil {
+			logger.Error("problem while saving statement", zap.Error(err))
+			continue
+		}
+		logger.Info("statement generated", zap.Int64("id", st.ID), zap.String("filename", st.Filename))
+
+		if s.notifier != nil {
+			err := s.notifier.Notify(ctx, invest.Notification{
+				Kind:    invest.NotificationStatement,
+				Subject: fmt.Sprintf("Statement %s for account %s", periodLabel(st.Period, st.From), st.AccountID),
+				Text: fmt.Sprintf("Statement %s of account %s for %s is ready, its id is %d.", st.Filename, st.AccountID,
+					periodLabel(st.Period, st.From), st.ID),
+				Data: toPbStatement(*st),
+			})
+			if err != nil {
+				logger.Error("problem while notifying about statement", zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Scheduler) exists(ctx context.Context, account *pb.Account, from time.Time) (bool, error) {
+	statements, err := s.storage.Statements(ctx, account.Provider, account.AccountId)
+	if err != nil {
+		return false, err
+	}
+	for _, st := range statements {
+		if st.Period == s.conf.Period && st.Format == s.conf.Format && st.From.Equal(from) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,218 @@
+package statement
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// Statement periods.
+const (
+	PeriodMonth   = "month"
+	PeriodQuarter = "quarter"
+)
+
+// Statement formats.
+const (
+	FormatHTML = "html"
+	FormatPDF  = "pdf"
+)
+
+var contentTypes = map[string]string{
+	FormatHTML: "text/html; charset=utf-8",
+	FormatPDF:  "application/pdf",
+}
+
+// Generate renders statement of the requested account for the period containing the requested date,
+// or for the last completed period when date is not set, and stores it.
+func Generate(ctx context.Context, provider invest.Provider, storage invest.Storage, req *pb.GenerateStatementRequest) (*pb.GenerateStatementResponse, error) {
+
+	if req.Account == nil || req.Account.AccountId == "" {
+		return nil, fmt.Errorf("%w: account is required", invest.ErrInvalidArgument)
+	}
+
+	period := req.Period
+	if period == "" {
+		period = PeriodMonth
+	}
+	format := req.Format
+	if format == "" {
+		format = FormatPDF
+	}
+
+	var (
+		from, to time.Time
+		err      error
+	)
+	if req.Date == "" {
+		from, to, err = LastCompleted(period, time.Now())
+	} else {
+		var date time.Time
+		date, err = parseDate(req.Date)
+		if err != nil {
+			return nil, err
+		}
+		from, to, err = Bounds(period, date)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := Render(ctx, provider, storage, req.Account, period, from, to, format, invest.NewRates(req.CurrencyRates))
+	if err != nil {
+		return nil, err
+	}
+	if err := storage.SaveStatement(ctx, st); err != nil {
+		return nil, err
+	}
+
+	return &pb.GenerateStatementResponse{
+		Statement: toPbStatement(*st),
+		Content:   st.Content,
+	}, nil
+}
+
+// Render collects statement data of account for [from, to) period and renders it in the given format.
+func Render(ctx context.Context, provider invest.Provider, storage invest.InstrumentStorage, account *pb.Account,
+	period string, from, to time.Time, format string, rates invest.Rates) (*invest.Statement, error) {
+
+	contentType, found := contentTypes[format]
+	if !found {
+		return nil, fmt.Errorf("%w: unknown statement format %q, supported formats are html and pdf", invest.ErrInvalidArgument, format)
+	}
+
+	data, err := Collect(ctx, provider, storage, account, period, from, to, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	var content []byte
+	switch format {
+	case FormatHTML:
+		content, err = RenderHTML(data)
+	case FormatPDF:
+		content, err = RenderPDF(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("render %s statement: %w", format, err)
+	}
+
+	return &invest.Statement{
+		Provider:    account.Provider,
+		AccountID:   account.AccountId,
+		Period:      period,
+		From:        from,
+		To:          to,
+		Format:      format,
+		Filename:    fmt.Sprintf("statement-%s-%s.%s", account.AccountId, periodKey(period, from), format),
+		ContentType: contentType,
+		Content:     content,
+		CreatedAt:   data.GeneratedAt,
+	}, nil
+}
+
+// Statements returns stored statements of account without content.
+func Statements(ctx context.Context, storage invest.StatementStorage, req *pb.StatementsRequest) (*pb.StatementsResponse, error) {
+
+	if req.Account == nil || req.Account.AccountId == "" {
+		return nil, fmt.Errorf("%w: account is required", invest.ErrInvalidArgument)
+	}
+
+	statements, err := storage.Statements(ctx, req.Account.Provider, req.Account.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StatementsResponse{Statements: make([]*pb.Statement, 0, len(statements))}
+	for _, st := range statements {
+		resp.Statements = append(resp.Statements, toPbStatement(st))
+	}
+	return resp, nil
+}
+
+// Get returns stored statement with its content.
+func Get(ctx context.Context, storage invest.StatementStorage, req *pb.StatementRequest) (*pb.StatementResponse, error) {
+
+	if req.Id <= 0 {
+		return nil, fmt.Errorf("%w: statement id is required", invest.ErrInvalidArgument)
+	}
+
+	st, err := storage.Statement(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StatementResponse{
+		Statement: toPbStatement(st),
+		Content:   st.Content,
+	}, nil
+}
+
+// Bounds returns [from, to) bounds in UTC of the period containing date.
+func Bounds(period string, date time.Time) (from, to time.Time, err error) {
+	date = date.UTC()
+	switch period {
+	case PeriodMonth:
+		from = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(0, 1, 0), nil
+	case PeriodQuarter:
+		month := (date.Month()-1)/3*3 + 1
+		from = time.Date(date.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(0, 3, 0), nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: unknown statement period %q, supported periods are month and quarter",
+			invest.ErrInvalidArgument, period)
+	}
+}
+
+// LastCompleted returns bounds of the last period completed before now.
+func LastCompleted(period string, now time.Time) (from, to time.Time, err error) {
+	current, _, err := Bounds(period, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return Bounds(period, current.Add(-time.Nanosecond))
+}
+
+// periodKey identifies period in file names, e.g. 2021-03 or 2021-Q1.
+func periodKey(period string, from time.Time) string {
+	if period == PeriodQuarter {
+		return fmt.Sprintf("%d-Q%d", from.Year(), (from.Month()-1)/3+1)
+	}
+	return from.Format("2006-01")
+}
+
+// periodLabel is a human readable period, e.g. March 2021 or Q1 2021.
+func periodLabel(period string, from time.Time) string {
+	if period == PeriodQuarter {
+		return fmt.Sprintf("Q%d %d", (from.Month()-1)/3+1, from.Year())
+	}
+	return from.Format("January 2006")
+}
+
+func parseDate(value string) (time.Time, error) {
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date, nil
+	}
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: parse statement date: %s", invest.ErrInvalidArgument, err)
+	}
+	return date, nil
+}
+
+func toPbStatement(st invest.Statement) *pb.Statement {
+	return &pb.Statement{
+		Id:          st.ID,
+		Account:     &pb.Account{AccountId: st.AccountID, Provider: st.Provider},
+		Period:      st.Period,
+		From:        st.From.Format(time.RFC3339),
+		To:          st.To.Format(time.RFC3339),
+		Format:      st.Format,
+		Filename:    st.Filename,
+		ContentType: st.ContentType,
+		CreatedAt:   st.CreatedAt.Format(time.RFC3339),
+	}
+}
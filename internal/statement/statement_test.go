@@ -0,0 +1,296 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testProvider struct {
+	invest.Provider
+	operationsFrom, operationsTo string
+}
+
+func (testProvider) Accounts(context.Context, *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	return &pb.AccountsResponse{Accounts: []*pb.Account{
+		{AccountId: "1", Provider: "tinkoff"},
+		{AccountId: "2", Provider: "manual"},
+	}}, nil
+}
+
+func (testProvider) Portfolio(context.Context, *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	return &pb.PortfolioResponse{
+		Positions: []*pb.Position{
+			{
+				Figi:                 "BBG004730N88",
+				Ticker:               "SBER",
+				Name:                 "Сбербанк России",
+				InstrumentType:       "Stock",
+				Balance:              100,
+				AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 250},
+				ExpectedYield:        &pb.Yield{Currency: "RUB", Value: 5000},
+			},
+			{
+				Figi:                 "BBG000B9XRY4",
+				Ticker:               "AAPL",
+				Name:                 "Apple",
+				InstrumentType:       "Stock",
+				Balance:              10,
+				AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 120},
+				ExpectedYield:        &pb.Yield{Currency: "USD", Value: -200},
+			},
+			{
+				Figi:           "BBG0013HGFT4",
+				InstrumentType: invest.InstrumentTypeCurrency,
+				Balance:        100,
+			},
+		},
+		Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 15000}, {Currency: "USD", Balance: 100}},
+	}, nil
+}
+
+func (p *testProvider) Operations(_ context.Context, req *pb.OperationsRequest) (*pb.OperationsResponse, error) {
+	p.operationsFrom, p.operationsTo = req.From, req.To
+	return &pb.OperationsResponse{Operations: []*pb.Operation{
+		{OperationType: pb.OperationType_OPERATION_TYPE_PAY_IN, Payment: 50000, Currency: "RUB"},
+		{OperationType: pb.OperationType_OPERATION_TYPE_BUY, Figi: "BBG004730N88", Payment: -25000, Currency: "RUB"},
+		{OperationType: pb.OperationType_OPERATION_TYPE_COMMISSION, Payment: -12.5, Currency: "RUB"},
+		{OperationType: pb.OperationType_OPERATION_TYPE_DIVIDEND, Figi: "BBG004730N88", Payment: 1870, Currency: "RUB"},
+		{OperationType: pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND, Figi: "BBG004730N88", Payment: -243, Currency: "RUB"},
+		{OperationType: pb.OperationType_OPERATION_TYPE_DIVIDEND, Figi: "BBG000B9XRY4", Payment: 2.05, Currency: "USD"},
+	}}, nil
+}
+
+type testStorage struct {
+	invest.Storage
+	statements []invest.Statement
+}
+
+func (testStorage) Instruments(context.Context, []string) (map[string]invest.Instrument, error) {
+	return map[string]invest.Instrument{"BBG004730N88": {Figi: "BBG004730N88", Sector: "Financials"}}, nil
+}
+
+func (s *testStorage) SaveStatement(_ context.Context, st *invest.Statement) error {
+	st.ID = int64(len(s.statements) + 1)
+	s.statements = append(s.statements, *st)
+	return nil
+}
+
+func (s *testStorage) Statements(_ context.Context, provider, accountID string) ([]invest.Statement, error) {
+	var statements []invest.Statement
+	for _, st := range s.statements {
+		if st.Provider == provider && st.AccountID == accountID {
+			st.Content = nil
+			statements = append(statements, st)
+		}
+	}
+	return statements, nil
+}
+
+func (s *testStorage) Statement(_ context.Context, id int64) (invest.Statement, error) {
+	if id < 1 || int(id) > len(s.statements) {
+		return invest.Statement{}, invest.ErrNotFound
+	}
+	return s.statements[id-1], nil
+}
+
+func TestBounds(t *testing.T) {
+
+	date := time.Date(2021, time.May, 17, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		period   string
+		from, to string
+		last     string
+	}{
+		{PeriodMonth, "2021-05-01", "2021-06-01", "2021-04-01"},
+		{PeriodQuarter, "2021-04-01", "2021-07-01", "2021-01-01"},
+	}
+
+	for _, tt := range tests {
+		from, to, err := Bounds(tt.period, date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if from.Format("2006-01-02") != tt.from || to.Format("2006-01-02") != tt.to {
+			t.Errorf("%s: unexpected bounds %s - %s", tt.period, from, to)
+		}
+		last, _, err := LastCompleted(tt.period, date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last.Format("2006-01-02") != tt.last {
+			t.Errorf("%s: unexpected last completed period %s", tt.period, last)
+		}
+	}
+
+	if _, _, err := Bounds("week", date); !errors.Is(err, invest.ErrInvalidArgument) {
+		t.Errorf("expected invalid argument for unknown period, got %v", err)
+	}
+}
+
+func TestCollect(t *testing.T) {
+
+	from, to, _ := Bounds(PeriodQuarter, time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC))
+	provider := &testProvider{}
+	account := &pb.Account{AccountId: "1", Provider: "tinkoff"}
+
+	data, err := Collect(context.Background(), provider, &testStorage{}, account, PeriodQuarter, from, to, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if provider.operationsFrom != "2021-01-01T00:00:00Z" || provider.operationsTo != "2021-03-31T23:59:59Z" {
+		t.Errorf("unexpected operations period %s - %s", provider.operationsFrom, provider.operationsTo)
+	}
+	if data.Label != "Q1 2021" {
+		t.Errorf("unexpected label %s", data.Label)
+	}
+
+	// currency position is skipped, holdings are sorted by value
+	if len(data.Holdings) != 2 || data.Holdings[0].Ticker != "SBER" || data.Holdings[0].Value != 30000 ||
+		data.Holdings[0].YieldPercent != 20 {
+		t.Errorf("unexpected holdings %v", data.Holdings)
+	}
+
+	if len(data.Activity) != 2 {
+		t.Fatalf("unexpected activity %v", data.Activity)
+	}
+	rub := data.Activity[0]
+	if rub.Currency != "RUB" || rub.Value != 45000 || rub.Yield != 5000 || rub.Deposits != 50000 || rub.Purchases != 25000 ||
+		rub.Commissions != 12.5 || rub.Income != 1870 || rub.Taxes != 243 || rub.NetFlow() != 50000 {
+		t.Errorf("unexpected rouble activity %+v", rub)
+	}
+
+	if len(data.Income) != 2 || data.Income[1].Ticker != "SBER" || data.Income[1].Net() != 1627 {
+		t.Errorf("unexpected income %+v", data.Income)
+	}
+
+	// dollar rate is not given
+	if data.Allocation != nil || !strings.Contains(data.AllocationNote, "USD") {
+		t.Errorf("allocation is expected to be skipped, got %v / %s", data.Allocation, data.AllocationNote)
+	}
+
+	data, err = Collect(context.Background(), provider, &testStorage{}, account, PeriodQuarter, from, to, invest.Rates{"USD": 75})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.Allocation == nil || data.Allocation.Total != 30000+(1200-200)*75+15000+100*75 {
+		t.Errorf("unexpected allocation %v", data.Allocation)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+
+	storage := &testStorage{}
+
+	for _, format := range []string{FormatHTML, FormatPDF} {
+		resp, err := Generate(context.Background(), &testProvider{}, storage, &pb.GenerateStatementRequest{
+			Account:       &pb.Account{AccountId: "1", Provider: "tinkoff"},
+			Period:        PeriodMonth,
+			Date:          "2021-03-15",
+			Format:        format,
+			CurrencyRates: []*pb.CurrencyRate{{Currency: "USD", Rate: 75}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		st := resp.Statement
+		if st.Filename != "statement-1-2021-03."+format || st.From != "2021-03-01T00:00:00Z" || st.To != "2021-04-01T00:00:00Z" {
+			t.Errorf("unexpected %s statement %v", format, st)
+		}
+
+		switch format {
+		case FormatHTML:
+			for _, text := range []string{"Portfolio statement, March 2021", "Сбербанк России", "45 000.00", "Financials"} {
+				if !bytes.Contains(resp.Content, []byte(text)) {
+					t.Errorf("html statement does not contain %q", text)
+				}
+			}
+		case FormatPDF:
+			if !bytes.HasPrefix(resp.Content, []byte("%PDF-")) {
+				t.Errorf("unexpected pdf content %q", resp.Content[:16])
+			}
+		}
+	}
+
+	if len(storage.statements) != 2 {
+		t.Fatalf("statements are not stored: %v", storage.statements)
+	}
+	resp, err := Get(context.Background(), storage, &pb.StatementRequest{Id: 2})
+	if err != nil || resp.Statement.Format != FormatPDF || len(resp.Content) == 0 {
+		t.Errorf("unexpected stored statement %v, %v", resp, err)
+	}
+
+	_, err = Generate(context.Background(), &testProvider{}, storage, &pb.GenerateStatementRequest{
+		Account: &pb.Account{AccountId: "1"},
+		Format:  "docx",
+	})
+	if !errors.Is(err, invest.ErrInvalidArgument) {
+		t.Errorf("expected invalid argument for unknown format, got %v", err)
+	}
+}
+
+func TestScheduler(t *testing.T) {
+
+	storage := &testStorage{}
+	notifier := &testNotifier{}
+	scheduler, err := NewScheduler(Config{Enabled: true, Format: FormatHTML, CurrencyRates: map[string]float64{"usd": 75}},
+		&testProvider{}, storage, notifier, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheduler.now = func() time.Time { return time.Date(2021, time.April, 2, 0, 0, 0, 0, time.UTC) }
+
+	for i := 0; i < 2; i++ {
+		if err := scheduler.RunOnce(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// statements are generated once for every account
+	if len(storage.statements) != 2 {
+		t.Fatalf("unexpected statements %v", storage.statements)
+	}
+	for _, st := range storage.statements {
+		if st.Period != PeriodMonth || st.From.Format("2006-01-02") != "2021-03-01" {
+			t.Errorf("unexpected statement %s of %s", st.Filename, st.From)
+		}
+		if !bytes.Contains(st.Content, []byte("By sector")) {
+			t.Errorf("allocation is not rendered with configured rates in %s", st.Filename)
+		}
+	}
+	if len(notifier.notifications) != 2 || notifier.notifications[0].Kind != invest.NotificationStatement ||
+		notifier.notifications[0].Subject != "Statement March 2021 for account 1" {
+		t.Errorf("unexpected notifications %v", notifier.notifications)
+	}
+}
+
+type testNotifier struct {
+	notifications []invest.Notification
+}
+
+func (n *testNotifier) Notify(_ context.Context, notification invest.Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func TestFormatMoney(t *testing.T) {
+
+	for value, expected := range map[float64]string{
+		0:          "0.00",
+		12.345:     "12.35",
+		-1234.5:    "-1 234.50",
+		1234567.25: "1 234 567.25",
+		-0.001:     "0.00",
+	} {
+		if got := formatMoney(value); got != expected {
+			t.Errorf("formatMoney(%v): expected %q, got %q", value, expected, got)
+		}
+	}
+}
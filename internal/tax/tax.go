@@ -0,0 +1,388 @@
+package tax
+
+import (
+	"context"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// baseRate is NDFL rate for residents.
+	baseRate = 0.13
+	// highRate is applied to the part of the tax base above highRateThreshold.
+	highRate          = 0.15
+	highRateThreshold = 5000000
+
+	// longHoldingYears is minimal holding period for long holding exemption (ЛДВ).
+	longHoldingYears = 3
+	// longHoldingLimit is exemption limit per every full year of holding.
+	longHoldingLimit = 3000000
+
+	// iisDeductionLimit is maximal annual contribution eligible for IIS deduction of type A.
+	iisDeductionLimit = 400000
+	// iisContributionLimit is maximal annual contribution to IIS.
+	iisContributionLimit = 1000000
+)
+
+var (
+	// longHoldingSince is the date since which acquired securities are eligible for long holding exemption.
+	longHoldingSince = time.Date(2014, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// historyStart is the date operations history is loaded from to restore purchase lots.
+	historyStart = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// Estimate loads operations history of the requested account and builds its yearly tax report.
+func Estimate(ctx context.Context, provider invest.Provider, req *pb.TaxReportRequest) (*pb.TaxReportResponse, error) {
+
+	if req.Account == nil || req.Account.AccountId == "" {
+		return nil, fmt.Errorf("%w: account is required", invest.ErrInvalidArgument)
+	}
+
+	if req.Year < int32(historyStart.Year()) || int(req.Year) > time.Now().Year() {
+		return nil, fmt.Errorf("%w: year %d is out of range", invest.ErrInvalidArgument, req.Year)
+	}
+
+	account := req.Account
+	if account.AccountType == pb.AccountType_TYPE_UNSPECIFIED {
+		accounts, err := provider.Accounts(ctx, &pb.AccountsRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("load accounts: %w", err)
+		}
+		for _, a := range accounts.Accounts {
+			if a.AccountId == account.AccountId {
+				account = a
+				break
+			}
+		}
+	}
+
+	yearEnd := time.Date(int(req.Year)+1, time.January, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+	operations, err := provider.Operations(ctx, &pb.OperationsRequest{
+		Account: account,
+		From:    historyStart.Format(time.RFC3339),
+		To:      yearEnd.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load operations: %w", err)
+	}
+
+	report, err := Report(account, int(req.Year), operations.Operations, invest.NewRates(req.CurrencyRates))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TaxReportResponse{Report: report}, nil
+}
+
+// lot is a purchased part of position which was not sold yet.
+type lot struct {
+	quantity int32
+	price    float64 // cost in roubles per unit including commission
+	date     time.Time
+}
+
+type operation struct {
+	*pb.Operation
+	date time.Time
+	// key identifies instrument by the first known of figi, isin and ticker.
+	key string
+}
+
+// Report calculates NDFL estimate for the given calendar year from the full operations history of an account.
+// A single rate per currency is used for the whole year, so the estimate differs from the official
+// calculation which uses Central Bank rate on every operation date.
+//
+// Realized gains are calculated by FIFO, sales of securities held more than three years are exempt
+// within the long holding limit. Foreign dividends are taxed with credit for the tax withheld abroad,
+// and contributions to IIS are tracked against the annual deduction limit.
+// Sales not covered by known purchases and sales without quantity are ignored, while repayment without quantity
+// redeems the whole position. Securities received by corporate action without price,
+// such as reverse split or merger, take cost and purchase dates of securities removed at the same time.
+// When nothing was removed, e.g. on forward split, received securities are added to the held lots of the instrument
+// keeping their cost, and receipt of securities which are not held is rejected.
+func Report(account *pb.Account, year int, operations []*pb.Operation, rates invest.Rates) (*pb.TaxReport, error) {
+
+	sorted := make([]operation, 0, len(operations))
+	for _, op := range operations {
+		date, err := time.Parse(time.RFC3339, op.Date)
+		if err != nil {
+			return nil, fmt.Errorf("operation %s has invalid date: %w", op.Id, err)
+		}
+		key := op.Figi
+		if key == "" {
+			key = op.Isin
+		}
+		if key == "" {
+			key = op.Ticker
+		}
+		sorted = append(sorted, operation{Operation: op, date: date, key: key})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].date.Equal(sorted[j].date) {
+			// securities removed by corporate action are processed before received ones to carry their cost
+			return sorted[i].OperationType == pb.OperationType_OPERATION_TYPE_SECURITY_OUT &&
+				sorted[j].OperationType != pb.OperationType_OPERATION_TYPE_SECURITY_OUT
+		}
+		return sorted[i].date.Before(sorted[j].date)
+	})
+
+	var (
+		report = &pb.TaxReport{
+			Account: account,
+			Year:    int32(year),
+		}
+		lots = make(map[string][]lot)
+		// lots removed by corporate actions by time of action, in order of removal
+		removed = make(map[int64][][]lot)
+
+		// long holding exemption aggregates
+		proceeds, weightedProceeds, longGain float64
+
+		// foreign dividends and taxes withheld abroad by instrument
+		foreignDividends = make(map[string]float64)
+		foreignWithheld  = make(map[string]float64)
+
+		contributions float64
+	)
+
+	for _, op := range sorted {
+
+		inYear := op.date.Year() == year
+		if op.date.Year() > year {
+			break
+		}
+
+		payment, err := rates.ToRUB(op.Payment, op.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		var commission float64
+		if op.Commission != nil {
+			commission, err = rates.ToRUB(math.Abs(op.Commission.Value), op.Commission.Currency)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch op.OperationType {
+
+		case pb.OperationType_OPERATION_TYPE_BUY, pb.OperationType_OPERATION_TYPE_SECURITY_IN:
+			if op.Quantity <= 0 {
+				continue
+			}
+			if op.OperationType == pb.OperationType_OPERATION_TYPE_SECURITY_IN && op.Price == 0 {
+				if queue := removed[op.date.UnixNano()]; len(queue) > 0 {
+					removed[op.date.UnixNano()] = queue[1:]
+					lots[op.key] = append(lots[op.key], convert(queue[0], op.Quantity)...)
+					continue
+				}
+				held := lots[op.key]
+				if len(held) == 0 {
+					return nil, fmt.Errorf("operation %s: %s received without price is neither held nor replaces removed securities",
+						op.Id, op.key)
+				}
+				total := op.Quantity
+				for _, l := range held {
+					total += l.quantity
+				}
+				lots[op.key] = convert(held, total)
+				continue
+			}
+			cost := math.Abs(payment) + commission
+			if op.OperationType == pb.OperationType_OPERATION_TYPE_SECURITY_IN {
+				cost, err = rates.ToRUB(op.Price*float64(op.Quantity), op.Currency)
+				if err != nil {
+					return nil, err
+				}
+			}
+			lots[op.key] = append(lots[op.key], lot{
+				quantity: op.Quantity,
+				price:    cost / float64(op.Quantity),
+				date:     op.date,
+			})
+
+		case pb.OperationType_OPERATION_TYPE_SELL, pb.OperationType_OPERATION_TYPE_REPAYMENT:
+			quantity := op.Quantity
+			if quantity <= 0 && op.OperationType == pb.OperationType_OPERATION_TYPE_REPAYMENT {
+				// redemption without quantity closes the whole position
+				for _, l := range lots[op.key] {
+					quantity += l.quantity
+				}
+			}
+			if quantity <= 0 {
+				continue
+			}
+
+			var matched []lot
+			lots[op.key], matched = take(lots[op.key], quantity)
+
+			income := math.Abs(payment) - commission
+			unitIncome := income / float64(quantity)
+			for _, l := range matched {
+				if !inYear {
+					continue
+				}
+				lotIncome := unitIncome * float64(l.quantity)
+				gain := lotIncome - l.price*float64(l.quantity)
+
+				proceeds += lotIncome
+				if years := fullYears(l.date, op.date); years >= longHoldingYears && !l.date.Before(longHoldingSince) {
+					weightedProceeds += lotIncome * float64(years)
+					if gain > 0 {
+						longGain += gain
+					}
+				}
+
+				if gain >= 0 {
+					report.RealizedGain += gain
+				} else {
+					report.RealizedLoss -= gain
+				}
+			}
+
+		case pb.OperationType_OPERATION_TYPE_SECURITY_OUT:
+			var matched []lot
+			lots[op.key], matched = take(lots[op.key], op.Quantity)
+			if len(matched) > 0 {
+				removed[op.date.UnixNano()] = append(removed[op.date.UnixNano()], matched)
+			}
+
+		case pb.OperationType_OPERATION_TYPE_DIVIDEND:
+			if !inYear {
+				continue
+			}
+			report.DividendIncome += payment
+			if invest.IsForeignCurrency(op.Currency) {
+				foreignDividends[op.key] += payment
+			}
+
+		case pb.OperationType_OPERATION_TYPE_COUPON:
+			if inYear {
+				report.CouponIncome += payment
+			}
+
+		case pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND:
+			if !inYear {
+				continue
+			}
+			if invest.IsForeignCurrency(op.Currency) {
+				foreignWithheld[op.key] += math.Abs(payment)
+				continue
+			}
+			report.TaxWithheld += math.Abs(payment)
+
+		case pb.OperationType_OPERATION_TYPE_TAX, pb.OperationType_OPERATION_TYPE_TAX_COUPON:
+			if inYear {
+				report.TaxWithheld += math.Abs(payment)
+			}
+
+		case pb.OperationType_OPERATION_TYPE_TAX_BACK:
+			if inYear {
+				report.TaxWithheld -= math.Abs(payment)
+			}
+
+		case pb.OperationType_OPERATION_TYPE_PAY_IN:
+			if inYear {
+				contributions += payment
+			}
+		}
+	}
+
+	if longGain > 0 && proceeds > 0 {
+		limit := longHoldingLimit * weightedProceeds / proceeds
+		report.ExemptGain = math.Min(longGain, limit)
+	}
+
+	for key, dividend := range foreignDividends {
+		report.ForeignTaxCredit += math.Min(foreignWithheld[key], dividend*baseRate)
+	}
+
+	securitiesBase := math.Max(0, report.RealizedGain-report.RealizedLoss-report.ExemptGain)
+	report.TaxBase = securitiesBase + report.CouponIncome + report.DividendIncome
+	report.TaxEstimate = math.Max(0, progressive(report.TaxBase)-report.ForeignTaxCredit)
+	report.TaxDue = math.Max(0, report.TaxEstimate-report.TaxWithheld)
+
+	if account.GetAccountType() == pb.AccountType_TYPE_IIS {
+		base := math.Min(contributions, iisDeductionLimit)
+		report.Iis = &pb.IisDeduction{
+			Contributions:  contributions,
+			DeductionBase:  base,
+			Deduction:      base * baseRate,
+			RemainingLimit: math.Max(0, iisContributionLimit-contributions),
+		}
+	}
+
+	return report, nil
+}
+
+// take removes quantity from lots by FIFO, returning remaining and matched lots.
+func take(lots []lot, quantity int32) (remaining []lot, matched []lot) {
+	for len(lots) > 0 && quantity > 0 {
+		l := lots[0]
+		if l.quantity > quantity {
+			matched = append(matched, lot{quantity: quantity, price: l.price, date: l.date})
+			lots[0].quantity -= quantity
+			break
+		}
+		matched = append(matched, l)
+		quantity -= l.quantity
+		lots = lots[1:]
+	}
+	return lots, matched
+}
+
+// convert distributes quantity over lots keeping their cost and purchase dates, e.g. two lots of 100 shares
+// become two lots of 10 shares on reverse split 1:10 and two lots of 200 shares on split 2:1.
+func convert(lots []lot, quantity int32) []lot {
+
+	var total int32
+	for _, l := range lots {
+		total += l.quantity
+	}
+	var (
+		result   = make([]lot, 0, len(lots))
+		assigned int32
+		carried  float64 // cost of lots too small to get a unit
+	)
+	for i, l := range lots {
+		q := int32(int64(l.quantity) * int64(quantity) / int64(total))
+		if i == len(lots)-1 {
+			q = quantity - assigned
+		}
+		cost := l.price*float64(l.quantity) + carried
+		if q <= 0 {
+			carried = cost
+			continue
+		}
+		assigned += q
+		carried = 0
+		result = append(result, lot{quantity: q, price: cost / float64(q), date: l.date})
+	}
+	if carried > 0 && len(result) > 0 {
+		last := &result[len(result)-1]
+		last.price += carried / float64(last.quantity)
+	}
+	return result
+}
+
+// fullYears returns number of full years between two dates.
+func fullYears(from, to time.Time) int {
+	years := to.Year() - from.Year()
+	if from.AddDate(years, 0, 0).After(to) {
+		years--
+	}
+	return years
+}
+
+// progressive returns tax for the base applying the increased rate above the threshold.
+func progressive(base float64) float64 {
+	if base <= highRateThreshold {
+		return base * baseRate
+	}
+	return highRateThreshold*baseRate + (base-highRateThreshold)*highRate
+}
@@ -0,0 +1,213 @@
+package tax
+
+import (
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"math"
+	"testing"
+)
+
+func op(t pb.OperationType, date, figi, currency string, quantity int32, payment float64) *pb.Operation {
+	return &pb.Operation{
+		Id:            date + figi,
+		OperationType: t,
+		Figi:          figi,
+		Date:          date,
+		Quantity:      quantity,
+		Payment:       payment,
+		Currency:      currency,
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestReport(t *testing.T) {
+
+	broker := &pb.Account{AccountId: "1", AccountType: pb.AccountType_TYPE_BROKER}
+	iis := &pb.Account{AccountId: "2", AccountType: pb.AccountType_TYPE_IIS}
+
+	tests := []struct {
+		name       string
+		account    *pb.Account
+		operations []*pb.Operation
+		rates      invest.Rates
+		check      func(t *testing.T, r *pb.TaxReport)
+	}{
+		{
+			name:    "fifo gain and loss",
+			account: broker,
+			operations: []*pb.Operation{
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-02-01T10:00:00Z", "A", "RUB", 10, -1000),
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-03-01T10:00:00Z", "A", "RUB", 10, -2000),
+				op(pb.OperationType_OPERATION_TYPE_SELL, "2021-04-01T10:00:00Z", "A", "RUB", 15, 2250),
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-02-01T10:00:00Z", "B", "RUB", 1, -500),
+				op(pb.OperationType_OPERATION_TYPE_SELL, "2021-05-01T10:00:00Z", "B", "RUB", 1, 400),
+			},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				// 10 lots by 100 and 5 lots by 200 sold by 150: +500 and -250, B: -100
+				if !almostEqual(r.RealizedGain, 500) || !almostEqual(r.RealizedLoss, 350) {
+					t.Errorf("unexpected gain %v / loss %v", r.RealizedGain, r.RealizedLoss)
+				}
+				if !almostEqual(r.TaxEstimate, 150*baseRate) {
+					t.Errorf("unexpected tax estimate %v", r.TaxEstimate)
+				}
+				if r.Iis != nil {
+					t.Errorf("broker account must not have iis deduction")
+				}
+			},
+		},
+		{
+			name:    "long holding exemption",
+			account: broker,
+			operations: []*pb.Operation{
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2016-01-10T10:00:00Z", "A", "RUB", 10, -1000),
+				op(pb.OperationType_OPERATION_TYPE_SELL, "2021-01-11T10:00:00Z", "A", "RUB", 10, 5000),
+			},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				if !almostEqual(r.ExemptGain, 4000) || r.TaxEstimate != 0 {
+					t.Errorf("unexpected exempt gain %v, tax %v", r.ExemptGain, r.TaxEstimate)
+				}
+			},
+		},
+		{
+			name:    "foreign dividend credit",
+			account: broker,
+			operations: []*pb.Operation{
+				op(pb.OperationType_OPERATION_TYPE_DIVIDEND, "2021-06-01T10:00:00Z", "US", "USD", 0, 100),
+				op(pb.OperationType_OPERATION_TYPE_TAX_DIVIDEND, "2021-06-01T10:00:00Z", "US", "USD", 0, -10),
+			},
+			rates: invest.Rates{"USD": 70},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				if !almostEqual(r.DividendIncome, 7000) || !almostEqual(r.ForeignTaxCredit, 700) {
+					t.Errorf("unexpected dividend %v / credit %v", r.DividendIncome, r.ForeignTaxCredit)
+				}
+				if !almostEqual(r.TaxDue, 7000*baseRate-700) {
+					t.Errorf("unexpected tax due %v", r.TaxDue)
+				}
+			},
+		},
+		{
+			name:    "reverse split carries cost",
+			account: broker,
+			operations: []*pb.Operation{
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-02-01T10:00:00Z", "A", "USD", 100, -1000),
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-03-01T10:00:00Z", "A", "USD", 100, -3000),
+				// received securities are listed before removed ones
+				op(pb.OperationType_OPERATION_TYPE_SECURITY_IN, "2021-04-01T10:00:00Z", "B", "USD", 20, 0),
+				op(pb.OperationType_OPERATION_TYPE_SECURITY_OUT, "2021-04-01T10:00:00Z", "A", "USD", 200, 0),
+				op(pb.OperationType_OPERATION_TYPE_SELL, "2021-05-01T10:00:00Z", "B", "USD", 15, 4500),
+			},
+			rates: invest.Rates{"USD": 1},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				// 10 units by 100 and 5 units by 300 sold by 300: +2000 and 0
+				if !almostEqual(r.RealizedGain, 2000) || r.RealizedLoss != 0 {
+					t.Errorf("unexpected gain %v / loss %v", r.RealizedGain, r.RealizedLoss)
+				}
+			},
+		},
+		{
+			name:    "split keeps cost",
+			account: broker,
+			operations: []*pb.Operation{
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-02-01T10:00:00Z", "A", "USD", 10, -1000),
+				// forward split 2:1 adds as many shares as held without removing them
+				op(pb.OperationType_OPERATION_TYPE_SECURITY_IN, "2021-04-01T10:00:00Z", "A", "USD", 10, 0),
+				op(pb.OperationType_OPERATION_TYPE_SELL, "2021-05-01T10:00:00Z", "A", "USD", 20, 1200),
+			},
+			rates: invest.Rates{"USD": 1},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				// 20 units by 50 sold by 60
+				if !almostEqual(r.RealizedGain, 200) || r.RealizedLoss != 0 {
+					t.Errorf("unexpected gain %v / loss %v", r.RealizedGain, r.RealizedLoss)
+				}
+			},
+		},
+		{
+			name:    "lots without figi",
+			account: broker,
+			operations: []*pb.Operation{
+				{Id: "1", OperationType: pb.OperationType_OPERATION_TYPE_BUY, Isin: "US0000000001", Date: "2021-02-01T10:00:00Z",
+					Quantity: 1, Payment: -100, Currency: "RUB"},
+				{Id: "2", OperationType: pb.OperationType_OPERATION_TYPE_BUY, Ticker: "OTHER", Date: "2021-02-01T10:00:00Z",
+					Quantity: 1, Payment: -500, Currency: "RUB"},
+				{Id: "3", OperationType: pb.OperationType_OPERATION_TYPE_SELL, Isin: "US0000000001", Date: "2021-03-01T10:00:00Z",
+					Quantity: 1, Payment: 300, Currency: "RUB"},
+			},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				if !almostEqual(r.RealizedGain, 200) || r.RealizedLoss != 0 {
+					t.Errorf("unexpected gain %v / loss %v", r.RealizedGain, r.RealizedLoss)
+				}
+			},
+		},
+		{
+			name:    "repayment without quantity",
+			account: broker,
+			operations: []*pb.Operation{
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-02-01T10:00:00Z", "A", "RUB", 10, -1000),
+				op(pb.OperationType_OPERATION_TYPE_BUY, "2021-02-01T10:00:00Z", "B", "RUB", 10, -1000),
+				// sale without quantity does not close the position
+				op(pb.OperationType_OPERATION_TYPE_SELL, "2021-03-01T10:00:00Z", "A", "RUB", 0, 5000),
+				op(pb.OperationType_OPERATION_TYPE_REPAYMENT, "2021-04-01T10:00:00Z", "B", "RUB", 0, 1100),
+			},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				if !almostEqual(r.RealizedGain, 100) || r.RealizedLoss != 0 {
+					t.Errorf("unexpected gain %v / loss %v", r.RealizedGain, r.RealizedLoss)
+				}
+			},
+		},
+		{
+			name:    "iis contributions",
+			account: iis,
+			operations: []*pb.Operation{
+				op(pb.OperationType_OPERATION_TYPE_PAY_IN, "2020-06-01T10:00:00Z", "", "RUB", 0, 100000),
+				op(pb.OperationType_OPERATION_TYPE_PAY_IN, "2021-06-01T10:00:00Z", "", "RUB", 0, 300000),
+				op(pb.OperationType_OPERATION_TYPE_PAY_IN, "2021-07-01T10:00:00Z", "", "RUB", 0, 200000),
+			},
+			check: func(t *testing.T, r *pb.TaxReport) {
+				if r.Iis == nil {
+					t.Fatal("iis deduction is missing")
+				}
+				if !almostEqual(r.Iis.Contributions, 500000) || !almostEqual(r.Iis.Deduction, 52000) ||
+					!almostEqual(r.Iis.RemainingLimit, 500000) {
+					t.Errorf("unexpected iis deduction %v", r.Iis)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Report(tt.account, 2021, tt.operations, tt.rates)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, report)
+		})
+	}
+}
+
+func TestReportMissingRate(t *testing.T) {
+	operations := []*pb.Operation{
+		op(pb.OperationType_OPERATION_TYPE_DIVIDEND, "2021-06-01T10:00:00Z", "US", "USD", 0, 100),
+	}
+	if _, err := Report(&pb.Account{}, 2021, operations, nil); err == nil {
+		t.Error("expected error for missing currency rate")
+	}
+}
+
+func TestReportUnknownReceivedSecurities(t *testing.T) {
+	operations := []*pb.Operation{
+		op(pb.OperationType_OPERATION_TYPE_SECURITY_IN, "2021-04-01T10:00:00Z", "A", "USD", 10, 0),
+	}
+	if _, err := Report(&pb.Account{}, 2021, operations, invest.Rates{"USD": 1}); err == nil {
+		t.Error("expected error for securities received without price and cost")
+	}
+}
+
+func TestProgressive(t *testing.T) {
+	if got := progressive(6000000); !almostEqual(got, 5000000*baseRate+1000000*highRate) {
+		t.Errorf("unexpected tax %v", got)
+	}
+}
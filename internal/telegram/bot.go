@@ -0,0 +1,434 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"html"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultURL         = "https://api.telegram.org"
+	defaultPollTimeout = 30 * time.Second
+	// maxRetryDelay bounds delay between failed polls.
+	maxRetryDelay = time.Minute
+	// recentEvents is how many triggered alerts /alerts shows.
+	recentEvents = 5
+)
+
+const help = `/accounts – list accounts
+/portfolio [account] – positions and cash
+/pnl [account] – unrealized profit and loss
+/alerts – alert rules and recent alerts`
+
+// Config configures Telegram bot.
+type Config struct {
+	Enabled bool   `yaml:"enabled"`
+	Token   string `yaml:"token" validate:"required_if=Enabled true" secret:"true"`
+	// URL of bot API, official API by default.
+	URL string `yaml:"url" validate:"omitempty,url"`
+	// PollTimeout is how long a poll for messages waits, thirty seconds by default.
+	PollTimeout time.Duration `yaml:"pollTimeout" validate:"gte=0"`
+}
+
+// Service is the part of invest service bot answers with.
+type Service interface {
+	GetAccounts(ctx context.Context, req *pb.AccountsRequest) (*pb.AccountsResponse, error)
+	GetPortfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error)
+	GetAlertRules(ctx context.Context, req *pb.AlertRulesRequest) (*pb.AlertRulesResponse, error)
+	GetAlertEvents(ctx context.Context, req *pb.AlertEventsRequest) (*pb.AlertEventsResponse, error)
+}
+
+// Bot answers portfolio queries of linked users in Telegram and delivers notifications to telegram channels.
+// Accounts have no owner, so every linked user intentionally sees all accounts and receives all
+// notifications, link codes are meant for trusted users of a single deployment only.
+type Bot struct {
+	conf    Config
+	client  *client
+	service Service
+	storage invest.Storage
+	logger  *zap.Logger
+	now     func() time.Time
+}
+
+func NewBot(conf Config, service Service, storage invest.Storage, logger *zap.Logger) (*Bot, error) {
+
+	if conf.Token == "" {
+		return nil, errors.New("telegram bot token is empty")
+	}
+	if service == nil {
+		return nil, errors.New("service provided to telegram bot is nil")
+	}
+	if storage == nil {
+		return nil, errors.New("storage provided to telegram bot is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger provided to telegram bot is nil")
+	}
+
+	if conf.URL == "" {
+		conf.URL = defaultURL
+	}
+	if conf.PollTimeout <= 0 {
+		conf.PollTimeout = defaultPollTimeout
+	}
+
+	return &Bot{
+		conf: conf,
+		// http timeout exceeds poll timeout, so long polls are not interrupted
+		client:  newClient(conf.URL, conf.Token, conf.PollTimeout+10*time.Second),
+		service: service,
+		storage: storage,
+		logger:  logger.With(zap.String("service", "telegram")),
+		now:     time.Now,
+	}, nil
+}
+
+// Run polls messages and answers them until context is done.
+func (b *Bot) Run(ctx context.Context) error {
+
+	var (
+		offset int64
+		delay  = time.Second
+	)
+	for {
+		updates, err := b.client.updates(ctx, offset, b.conf.PollTimeout)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			b.logger.Error("problem while polling telegram updates", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(delay):
+			}
+			if delay *= 2; delay > maxRetryDelay {
+				delay = maxRetryDelay
+			}
+			continue
+		}
+		delay = time.Second
+
+		for _, u := range updates {
+			offset = u.UpdateID + 1
+			if u.Message == nil || u.Message.From == nil || u.Message.Text == "" {
+				continue
+			}
+			reply := b.handle(ctx, u.Message.From.ID, u.Message.Chat.ID, u.Message.Text)
+			for _, text := range split(reply) {
+				if err := b.client.send(ctx, u.Message.Chat.ID, text); err != nil {
+					b.logger.Error("problem while sending telegram message", zap.Int64("chat", u.Message.Chat.ID),
+						zap.Error(err))
+					break
+				}
+			}
+		}
+	}
+}
+
+// handle returns HTML reply to message of Telegram user.
+func (b *Bot) handle(ctx context.Context, telegramID, chatID int64, text string) string {
+
+	fields := strings.Fields(text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return "Send a command, see /help."
+	}
+	// commands in group chats are suffixed with bot name
+	command := strings.SplitN(strings.ToLower(fields[0]), "@", 2)[0]
+	var arg string
+	if len(fields) > 1 {
+		arg = fields[1]
+	}
+	logger := b.logger.With(zap.Int64("telegramUser", telegramID), zap.String("command", command))
+
+	if command == "/start" && arg != "" {
+		reply, err := b.link(ctx, arg, telegramID, chatID)
+		if err != nil {
+			logger.Error("problem while linking telegram account", zap.Error(err))
+			return "Sorry, linking failed, try again later."
+		}
+		return reply
+	}
+
+	u, err := b.storage.UserByTelegramID(ctx, telegramID)
+	if errors.Is(err, invest.ErrNotFound) {
+		return "This Telegram account is not linked. Ask for a link code and send <code>/start CODE</code>."
+	}
+	if err != nil {
+		logger.Error("problem while loading user", zap.Error(err))
+		return "Sorry, request failed, try again later."
+	}
+	logger = logger.With(zap.String("user", u.Name))
+
+	var reply string
+	switch command {
+	case "/start", "/help":
+		reply = fmt.Sprintf("Hi, %s!\n\n%s", escape(u.Name), help)
+	case "/accounts":
+		reply, err = b.accounts(ctx)
+	case "/portfolio":
+		reply, err = b.portfolio(ctx, arg)
+	case "/pnl":
+		reply, err = b.pnl(ctx, arg)
+	case "/alerts":
+		reply, err = b.alerts(ctx)
+	default:
+		reply = "Unknown command, see /help."
+	}
+	if err != nil {
+		logger.Error("problem while answering telegram command", zap.Error(err))
+		return "Sorry, request failed, try again later."
+	}
+	return reply
+}
+
+func (b *Bot) accounts(ctx context.Context) (string, error) {
+
+	accounts, err := b.service.GetAccounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		return "", err
+	}
+	if len(accounts.Accounts) == 0 {
+		return "No accounts.", nil
+	}
+
+	t := newTable("Account", "Type", "Provider").text(1, 2)
+	for _, a := range accounts.Accounts {
+		t.add(a.AccountId, accountType(a.AccountType), a.Provider)
+	}
+	return t.String(), nil
+}
+
+func (b *Bot) portfolio(ctx context.Context, accountID string) (string, error) {
+
+	return b.eachAccount(ctx, accountID, func(account *pb.Account, portfolio *pb.PortfolioResponse) string {
+
+		var (
+			t      = newTable("Ticker", "Qty", "Price", "Value", "Ccy").text(4)
+			totals = make(map[string]float64)
+		)
+		for _, p := range portfolio.Positions {
+			if p.InstrumentType == invest.InstrumentTypeCurrency {
+				continue
+			}
+			value, currency := invest.PositionValue(p)
+			price, _ := invest.PositionPrice(p)
+			t.add(ticker(p), formatQuantity(p.Balance), formatAmount(price), formatAmount(value), currency)
+			totals[currency] += value
+		}
+
+		var b strings.Builder
+		if len(t.rows) == 0 {
+			b.WriteString("No positions.")
+		} else {
+			b.WriteString(t.String())
+		}
+		var cash []string
+		for _, c := range portfolio.Currencies {
+			cash = append(cash, formatAmount(c.Balance)+" "+c.Currency)
+			totals[c.Currency] += c.Balance
+		}
+		if len(cash) > 0 {
+			b.WriteString("\nCash: " + strings.Join(cash, ", "))
+		}
+		if len(totals) > 0 {
+			b.WriteString("\nTotal: " + joinTotals(totals, formatAmount))
+		}
+		return b.String()
+	})
+}
+
+func (b *Bot) pnl(ctx context.Context, accountID string) (string, error) {
+
+	return b.eachAccount(ctx, accountID, func(account *pb.Account, portfolio *pb.PortfolioResponse) string {
+
+		var (
+			t     = newTable("Ticker", "P&L", "%", "Ccy").text(3)
+			pnl   = make(map[string]float64)
+			costs = make(map[string]float64)
+		)
+		for _, p := range portfolio.Positions {
+			if p.InstrumentType == invest.InstrumentTypeCurrency {
+				continue
+			}
+			_, currency := invest.PositionValue(p)
+			yield := p.ExpectedYield.GetValue()
+			cost := p.AveragePositionPrice.GetValue() * p.Balance
+			percent := "-"
+			if cost != 0 {
+				percent = formatPercent(yield / cost * 100)
+			}
+			t.add(ticker(p), formatAmount(yield), percent, currency)
+			pnl[currency] += yield
+			costs[currency] += cost
+		}
+		if len(t.rows) == 0 {
+			return "No positions."
+		}
+
+		totals := make([]string, 0, len(pnl))
+		for _, currency := range sortedKeys(pnl) {
+			total := formatAmount(pnl[currency]) + " " + currency
+			if costs[currency] != 0 {
+				total += " (" + formatPercent(pnl[currency]/costs[currency]*100) + ")"
+			}
+			totals = append(totals, total)
+		}
+		return t.String() + "\nTotal: " + html.EscapeString(strings.Join(totals, ", "))
+	})
+}
+
+func (b *Bot) alerts(ctx context.Context) (string, error) {
+
+	rules, err := b.service.GetAlertRules(ctx, &pb.AlertRulesRequest{})
+	if err != nil {
+		return "", err
+	}
+	events, err := b.service.GetAlertEvents(ctx, &pb.AlertEventsRequest{Limit: recentEvents})
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		reply    strings.Builder
+		disabled int
+		t        = newTable("#", "Type", "Target", "Limit", "Last").text(1, 2)
+	)
+	for _, r := range rules.Rules {
+		if !r.Enabled {
+			disabled++
+			continue
+		}
+		target := r.Figi
+		if target == "" {
+			target = r.Account.GetAccountId()
+		}
+		threshold := formatAmount(r.Threshold)
+		if r.Type == pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN || r.Type == pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE {
+			threshold += "%"
+		}
+		last := "-"
+		if triggered, err := time.Parse(time.RFC3339, r.TriggeredAt); err == nil {
+			last = triggered.Format("02.01")
+		}
+		t.add(fmt.Sprint(r.Id), alertType(r.Type), target, threshold, last)
+	}
+
+	if len(t.rows) == 0 {
+		reply.WriteString("No active alert rules.")
+	} else {
+		reply.WriteString(t.String())
+	}
+	if disabled > 0 {
+		fmt.Fprintf(&reply, "\n%d disabled rules are not shown.", disabled)
+	}
+	if len(events.Events) > 0 {
+		reply.WriteString("\n\n<b>Recent alerts</b>")
+		for _, e := range events.Events {
+			when := e.TriggeredAt
+			if triggered, err := time.Parse(time.RFC3339, e.TriggeredAt); err == nil {
+				when = triggered.Format("02.01 15:04")
+			}
+			reply.WriteString("\n• " + escape(when) + " " + escape(e.Message))
+		}
+	}
+	return reply.String(), nil
+}
+
+// eachAccount renders portfolio of the account, or of every account when it is empty, under account headings.
+func (b *Bot) eachAccount(ctx context.Context, accountID string,
+	render func(*pb.Account, *pb.PortfolioResponse) string) (string, error) {
+
+	accounts, err := b.service.GetAccounts(ctx, &pb.AccountsRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	var selected []*pb.Account
+	for _, a := range accounts.Accounts {
+		if accountID == "" || a.AccountId == accountID {
+			selected = append(selected, a)
+		}
+	}
+	if len(selected) == 0 {
+		if accountID != "" {
+			return fmt.Sprintf("Account %s is not found, see /accounts.", escape(accountID)), nil
+		}
+		return "No accounts.", nil
+	}
+
+	parts := make([]string, 0, len(selected))
+	for _, account := range selected {
+		portfolio, err := b.service.GetPortfolio(ctx, &pb.PortfolioRequest{Account: account})
+		if err != nil {
+			return "", fmt.Errorf("load portfolio of account %s: %w", account.AccountId, err)
+		}
+		heading := "<b>" + escape(account.AccountId) + "</b>"
+		if account.Provider != "" {
+			heading += " " + escape(account.Provider)
+		}
+		parts = append(parts, heading+"\n"+render(account, portfolio))
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
+
+func ticker(p *pb.Position) string {
+	if p.Ticker != "" {
+		return p.Ticker
+	}
+	return p.Figi
+}
+
+func accountType(t pb.AccountType) string {
+	switch t {
+	case pb.AccountType_TYPE_BROKER:
+		return "broker"
+	case pb.AccountType_TYPE_IIS:
+		return "iis"
+	default:
+		return "-"
+	}
+}
+
+func alertType(t pb.AlertType) string {
+	switch t {
+	case pb.AlertType_ALERT_TYPE_PRICE_ABOVE:
+		return "above"
+	case pb.AlertType_ALERT_TYPE_PRICE_BELOW:
+		return "below"
+	case pb.AlertType_ALERT_TYPE_POSITION_DRAWDOWN:
+		return "drawdown"
+	case pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE:
+		return "daily"
+	default:
+		return "-"
+	}
+}
+
+func joinTotals(totals map[string]float64, format func(float64) string) string {
+	parts := make([]string, 0, len(totals))
+	for _, currency := range sortedKeys(totals) {
+		parts = append(parts, format(totals[currency])+" "+currency)
+	}
+	return html.EscapeString(strings.Join(parts, ", "))
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func escape(s string) string {
+	return html.EscapeString(s)
+}
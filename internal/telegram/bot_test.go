@@ -0,0 +1,378 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"go.uber.org/zap"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"goinvest/internal/notify"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testStorage struct {
+	invest.Storage
+	users    []invest.User
+	channels []invest.NotificationChannel
+}
+
+func (s *testStorage) User(_ context.Context, name string) (invest.User, error) {
+	return s.find(func(u invest.User) bool { return u.Name == name })
+}
+
+func (s *testStorage) UserByTelegramID(_ context.Context, telegramID int64) (invest.User, error) {
+	return s.find(func(u invest.User) bool { return u.TelegramID == telegramID })
+}
+
+func (s *testStorage) UserByLinkCode(_ context.Context, code string) (invest.User, error) {
+	return s.find(func(u invest.User) bool { return u.LinkCode == code })
+}
+
+func (s *testStorage) find(match func(invest.User) bool) (invest.User, error) {
+	for _, u := range s.users {
+		if match(u) {
+			return u, nil
+		}
+	}
+	return invest.User{}, invest.ErrNotFound
+}
+
+func (s *testStorage) SaveUser(_ context.Context, u *invest.User) error {
+	if u.ID == 0 {
+		u.ID = int64(len(s.users) + 1)
+		s.users = append(s.users, *u)
+		return nil
+	}
+	for i := range s.users {
+		if s.users[i].ID == u.ID {
+			s.users[i] = *u
+			return nil
+		}
+	}
+	return invest.ErrNotFound
+}
+
+func (s *testStorage) NotificationChannels(_ context.Context, user string) ([]invest.NotificationChannel, error) {
+	var channels []invest.NotificationChannel
+	for _, c := range s.channels {
+		if c.User == user {
+			channels = append(channels, c)
+		}
+	}
+	return channels, nil
+}
+
+func (s *testStorage) SaveNotificationChannel(_ context.Context, channel *invest.NotificationChannel) error {
+	channel.ID = int64(len(s.channels) + 1)
+	s.channels = append(s.channels, *channel)
+	return nil
+}
+
+type testService struct {
+	accounts   []*pb.Account
+	portfolios map[string]*pb.PortfolioResponse
+	rules      []*pb.AlertRule
+	events     []*pb.AlertEvent
+}
+
+func (s *testService) GetAccounts(context.Context, *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	return &pb.AccountsResponse{Accounts: s.accounts}, nil
+}
+
+func (s *testService) GetPortfolio(_ context.Context, req *pb.PortfolioRequest) (*pb.PortfolioResponse, error) {
+	return s.portfolios[req.Account.AccountId], nil
+}
+
+func (s *testService) GetAlertRules(context.Context, *pb.AlertRulesRequest) (*pb.AlertRulesResponse, error) {
+	return &pb.AlertRulesResponse{Rules: s.rules}, nil
+}
+
+func (s *testService) GetAlertEvents(context.Context, *pb.AlertEventsRequest) (*pb.AlertEventsResponse, error) {
+	return &pb.AlertEventsResponse{Events: s.events}, nil
+}
+
+func newTestBot(t *testing.T, service Service, storage invest.Storage) *Bot {
+	b, err := NewBot(Config{Token: "token"}, service, storage, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestLink(t *testing.T) {
+
+	ctx := context.Background()
+	storage := &testStorage{users: []invest.User{{ID: 1, Name: "old", TelegramID: 42}}}
+	b := newTestBot(t, &testService{}, storage)
+
+	if reply := b.handle(ctx, 7, 7, "/portfolio"); !strings.Contains(reply, "not linked") {
+		t.Errorf("unlinked user reply = %q", reply)
+	}
+
+	link, err := CreateLink(ctx, storage, &pb.TelegramLinkRequest{User: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(link.Code) != 16 {
+		t.Errorf("code = %q, want 16 characters", link.Code)
+	}
+
+	if reply := b.handle(ctx, 42, 100, "/start wrong"); reply != "Link code is invalid." {
+		t.Errorf("invalid code reply = %q", reply)
+	}
+	if reply := b.handle(ctx, 42, 100, "/start@invest_bot "+link.Code); !strings.Contains(reply, "Linked to user <b>alice</b>") {
+		t.Errorf("link reply = %q", reply)
+	}
+
+	alice, err := storage.User(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alice.TelegramID != 42 || alice.LinkCode != "" {
+		t.Errorf("linked user = %+v", alice)
+	}
+	if old, _ := storage.User(ctx, "old"); old.TelegramID != 0 {
+		t.Errorf("previously linked user keeps telegram id %d", old.TelegramID)
+	}
+	want := invest.NotificationChannel{ID: 1, User: "alice", Type: notify.ChannelTelegram, Target: "100", Enabled: true}
+	if len(storage.channels) != 1 {
+		t.Fatalf("channels = %+v, want one", storage.channels)
+	}
+	got := storage.channels[0]
+	got.CreatedAt = time.Time{}
+	if got.ID != want.ID || got.User != want.User || got.Type != want.Type || got.Target != want.Target || !got.Enabled {
+		t.Errorf("channel = %+v, want %+v", got, want)
+	}
+
+	// code is single use
+	if reply := b.handle(ctx, 42, 100, "/start "+link.Code); reply != "Link code is invalid." {
+		t.Errorf("reused code reply = %q", reply)
+	}
+
+	link, err = CreateLink(ctx, storage, &pb.TelegramLinkRequest{User: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.now = func() time.Time { return time.Now().Add(time.Hour) }
+	if reply := b.handle(ctx, 42, 100, "/start "+link.Code); !strings.Contains(reply, "expired") {
+		t.Errorf("expired code reply = %q", reply)
+	}
+
+	if _, err := CreateLink(ctx, storage, &pb.TelegramLinkRequest{}); err == nil {
+		t.Error("link without user is created")
+	}
+}
+
+func TestCommands(t *testing.T) {
+
+	ctx := context.Background()
+	storage := &testStorage{users: []invest.User{{ID: 1, Name: "alice", TelegramID: 42}}}
+	account := &pb.Account{Provider: "tinkoff", AccountId: "2000", AccountType: pb.AccountType_TYPE_BROKER}
+	service := &testService{
+		accounts: []*pb.Account{account},
+		portfolios: map[string]*pb.PortfolioResponse{
+			"2000": {
+				Positions: []*pb.Position{
+					{
+						Figi:                 "BBG000B9XRY4",
+						Ticker:               "AAPL",
+						InstrumentType:       "Stock",
+						Balance:              10,
+						AveragePositionPrice: &pb.Yield{Currency: "USD", Value: 100},
+						ExpectedYield:        &pb.Yield{Currency: "USD", Value: 50},
+					},
+					{
+						Figi:                 "BBG004730N88",
+						Ticker:               "SBER",
+						InstrumentType:       "Stock",
+						Balance:              100,
+						AveragePositionPrice: &pb.Yield{Currency: "RUB", Value: 250},
+						ExpectedYield:        &pb.Yield{Currency: "RUB", Value: -2500},
+					},
+				},
+				Currencies: []*pb.CurrencyBalance{{Currency: "RUB", Balance: 1000}},
+			},
+		},
+		rules: []*pb.AlertRule{
+			{Id: 1, Type: pb.AlertType_ALERT_TYPE_PRICE_ABOVE, Figi: "BBG000B9XRY4", Threshold: 200, Enabled: true,
+				TriggeredAt: "2021-03-01T10:00:00Z"},
+			{Id: 2, Type: pb.AlertType_ALERT_TYPE_PORTFOLIO_DAILY_CHANGE, Account: account, Threshold: 5},
+		},
+		events: []*pb.AlertEvent{{RuleId: 1, Message: "AAPL price 201 > 200", TriggeredAt: "2021-03-01T10:00:00Z"}},
+	}
+	b := newTestBot(t, service, storage)
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"/accounts", []string{"<pre>Account Type   Provider\n2000    broker tinkoff</pre>"}},
+		{"/portfolio", []string{
+			"<b>2000</b> tinkoff",
+			"AAPL    10   105  1050 USD",
+			"SBER   100   225 22500 RUB",
+			"Cash: 1000 RUB",
+			"Total: 23500 RUB, 1050 USD",
+		}},
+		{"/portfolio 3000", []string{"Account 3000 is not found"}},
+		{"/pnl 2000", []string{
+			"AAPL      50  +5.0% USD",
+			"SBER   -2500 -10.0% RUB",
+			"Total: -2500 RUB (-10.0%), 50 USD (+5.0%)",
+		}},
+		{"/alerts", []string{
+			"1 above BBG000B9X…   200 01.03",
+			"1 disabled rules are not shown.",
+			"• 01.03 10:00 AAPL price 201 &gt; 200",
+		}},
+		{"/help", []string{"Hi, alice!", "/pnl [account]"}},
+		{"/unknown", []string{"Unknown command"}},
+	}
+	for _, tt := range tests {
+		reply := b.handle(ctx, 42, 42, tt.text)
+		for _, want := range tt.want {
+			if !strings.Contains(reply, want) {
+				t.Errorf("%s reply = %q, want it to contain %q", tt.text, reply, want)
+			}
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+
+	var (
+		mu   sync.Mutex
+		sent []map[string]interface{}
+		// messages are delivered once, the next polls return nothing
+		polled bool
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var params map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&params)
+		switch r.URL.Path {
+		case "/bottoken/getUpdates":
+			result := "[]"
+			if !polled {
+				polled = true
+				result = `[{"update_id":5,"message":{"message_id":1,"from":{"id":42},"chat":{"id":100},"text":"/help"}}]`
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+		case "/bottoken/sendMessage":
+			sent = append(sent, params)
+			_, _ = w.Write([]byte(`{"ok":true,"result":{}}`))
+			cancel()
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"ok":false,"description":"Not Found"}`))
+		}
+	}))
+	defer server.Close()
+
+	storage := &testStorage{users: []invest.User{{ID: 1, Name: "alice", TelegramID: 42}}}
+	b, err := NewBot(Config{Token: "token", URL: server.URL, PollTimeout: time.Second}, &testService{}, storage, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(sent) != 1 {
+		t.Fatalf("sent %d messages, want 1", len(sent))
+	}
+	if sent[0]["chat_id"] != float64(100) || sent[0]["parse_mode"] != "HTML" || !strings.Contains(sent[0]["text"].(string), "Hi, alice!") {
+		t.Errorf("sent message = %v", sent[0])
+	}
+}
+
+func TestSender(t *testing.T) {
+
+	var message map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&message)
+		if message["chat_id"] == "blocked" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`))
+			return
+		}
+		if message["chat_id"] == "busy" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{}}`))
+	}))
+	defer server.Close()
+
+	b, err := NewBot(Config{Token: "token", URL: server.URL}, &testService{}, &testStorage{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := b.Sender(time.Second)
+	notification := invest.Notification{Subject: "Alert <SBER>", Text: "price is above 300 & rising"}
+
+	if err := sender.Send(context.Background(), invest.NotificationChannel{Target: "@channel"}, notification); err != nil {
+		t.Fatal(err)
+	}
+	if message["chat_id"] != "@channel" || message["parse_mode"] != "HTML" ||
+		message["text"] != "<b>Alert &lt;SBER&gt;</b>\n\nprice is above 300 &amp; rising" {
+		t.Errorf("sent message = %v", message)
+	}
+
+	err = sender.Send(context.Background(), invest.NotificationChannel{Target: "blocked"}, notification)
+	if !notify.IsPermanent(err) || !strings.Contains(err.Error(), "bot was blocked") {
+		t.Errorf("want permanent error, got %v", err)
+	}
+	err = sender.Send(context.Background(), invest.NotificationChannel{Target: "busy"}, notification)
+	if err == nil || notify.IsPermanent(err) {
+		t.Errorf("want temporary error, got %v", err)
+	}
+}
+
+func TestSplit(t *testing.T) {
+
+	t1 := newTable("Ticker", "Value")
+	for i := 0; i < 500; i++ {
+		t1.add("TICKER", "1000000")
+	}
+	messages := split("<b>Portfolio</b>\n" + t1.String())
+	if len(messages) < 2 {
+		t.Fatalf("split into %d messages, want several", len(messages))
+	}
+	for i, m := range messages {
+		if len(m) > maxMessage {
+			t.Errorf("message %d has %d bytes", i, len(m))
+		}
+		if strings.Count(m, "<pre>") != strings.Count(m, "</pre>") {
+			t.Errorf("message %d has unbalanced pre tags", i)
+		}
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+
+	tests := map[float64]string{
+		0:        "0",
+		12.5:     "12.50",
+		-2500:    "-2500",
+		99999.99: "99999.99",
+		123456:   "123.5k",
+		2500000:  "2.50M",
+	}
+	for v, want := range tests {
+		if got := formatAmount(v); got != want {
+			t.Errorf("formatAmount(%v) = %q, want %q", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// client is a minimal Telegram bot API client with long polling.
+type client struct {
+	url   string
+	token string
+	http  *http.Client
+}
+
+type update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *message `json:"message"`
+}
+
+type message struct {
+	MessageID int64  `json:"message_id"`
+	From      *user  `json:"from"`
+	Chat      chat   `json:"chat"`
+	Text      string `json:"text"`
+}
+
+type user struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+type chat struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
+
+// apiError is a request rejected by bot API.
+type apiError struct {
+	method      string
+	status      int
+	description string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.method, e.status, e.description)
+}
+
+func newClient(url, token string, timeout time.Duration) *client {
+	return &client{
+		url:   strings.TrimRight(url, "/"),
+		token: token,
+		http:  &http.Client{Timeout: timeout},
+	}
+}
+
+// updates waits up to timeout for messages sent after offset.
+func (c *client) updates(ctx context.Context, offset int64, timeout time.Duration) ([]update, error) {
+	var updates []update
+	err := c.call(ctx, "getUpdates", map[string]interface{}{
+		"offset":          offset,
+		"timeout":         int(timeout.Seconds()),
+		"allowed_updates": []string{"message"},
+	}, &updates)
+	return updates, err
+}
+
+// send sends HTML formatted message to chat, chat is a numeric id or @channel name.
+func (c *client) send(ctx context.Context, chat interface{}, text string) error {
+	return c.call(ctx, "sendMessage", map[string]interface{}{
+		"chat_id":                  chat,
+		"text":                     text,
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}, nil)
+}
+
+func (c *client) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal %s request: %w", method, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+"/bot"+c.token+"/"+method, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		// error of client contains url with token
+		return fmt.Errorf("call %s: %s", method, strings.ReplaceAll(err.Error(), c.token, "***"))
+	}
+	defer resp.Body.Close()
+
+	var envelope struct {
+		OK          bool            `json:"ok"`
+		Description string          `json:"description"`
+		Result      json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("decode %s response with status %d: %w", method, resp.StatusCode, err)
+	}
+	if !envelope.OK {
+		return &apiError{method: method, status: resp.StatusCode, description: envelope.Description}
+	}
+	if result != nil {
+		if err := json.Unmarshal(envelope.Result, result); err != nil {
+			return fmt.Errorf("decode %s result: %w", method, err)
+		}
+	}
+	return nil
+}
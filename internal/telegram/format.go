@@ -0,0 +1,141 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxCell is the widest table cell, phone screens fit about 35 monospace characters.
+	maxCell = 10
+	// maxMessage is Telegram limit of message length.
+	maxMessage = 4096
+)
+
+// table renders rows as a compact monospace table, numeric columns are right aligned.
+type table struct {
+	headers []string
+	numeric []bool
+	rows    [][]string
+}
+
+func newTable(headers ...string) *table {
+	t := &table{headers: headers, numeric: make([]bool, len(headers))}
+	// the first column is a name, the rest are numbers unless marked otherwise
+	for i := 1; i < len(headers); i++ {
+		t.numeric[i] = true
+	}
+	return t
+}
+
+// text marks columns as text, they are left aligned.
+func (t *table) text(columns ...int) *table {
+	for _, c := range columns {
+		t.numeric[c] = false
+	}
+	return t
+}
+
+func (t *table) add(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// String renders table as preformatted HTML block.
+func (t *table) String() string {
+
+	widths := make([]int, len(t.headers))
+	cell := func(s string) string {
+		if utf8.RuneCountInString(s) <= maxCell {
+			return s
+		}
+		return string([]rune(s)[:maxCell-1]) + "…"
+	}
+	for _, row := range append([][]string{t.headers}, t.rows...) {
+		for i, c := range row {
+			if w := utf8.RuneCountInString(cell(c)); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("<pre>")
+	for r, row := range append([][]string{t.headers}, t.rows...) {
+		if r > 0 {
+			b.WriteByte('\n')
+		}
+		var line strings.Builder
+		for i, c := range row {
+			if i > 0 {
+				line.WriteByte(' ')
+			}
+			c = cell(c)
+			pad := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(c))
+			if t.numeric[i] {
+				line.WriteString(pad + c)
+			} else {
+				line.WriteString(c + pad)
+			}
+		}
+		b.WriteString(html.EscapeString(strings.TrimRight(line.String(), " ")))
+	}
+	b.WriteString("</pre>")
+	return b.String()
+}
+
+// formatAmount formats money compactly: large values are shortened with k and M suffixes.
+func formatAmount(v float64) string {
+	switch abs := math.Abs(v); {
+	case abs >= 1e6:
+		return strconv.FormatFloat(v/1e6, 'f', 2, 64) + "M"
+	case abs >= 1e5:
+		return strconv.FormatFloat(v/1e3, 'f', 1, 64) + "k"
+	default:
+		return strings.TrimSuffix(strconv.FormatFloat(v, 'f', 2, 64), ".00")
+	}
+}
+
+func formatQuantity(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatPercent(v float64) string {
+	return fmt.Sprintf("%+.1f%%", v)
+}
+
+// split breaks long reply into messages at line boundaries. Preformatted blocks are reopened
+// in the next message, so every message is valid HTML.
+func split(text string) []string {
+
+	if len(text) <= maxMessage {
+		return []string{text}
+	}
+
+	var (
+		messages []string
+		current  strings.Builder
+		pre      bool
+	)
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if current.Len()+len(line)+len("</pre>") > maxMessage && current.Len() > 0 {
+			if pre {
+				current.WriteString("</pre>")
+			}
+			messages = append(messages, current.String())
+			current.Reset()
+			if pre {
+				current.WriteString("<pre>")
+			}
+		}
+		current.WriteString(line)
+		pre = strings.LastIndex(current.String(), "<pre>") > strings.LastIndex(current.String(), "</pre>")
+	}
+	if current.Len() > 0 {
+		messages = append(messages, current.String())
+	}
+	return messages
+}
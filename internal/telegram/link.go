@@ -0,0 +1,114 @@
+package telegram
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"goinvest/internal/notify"
+	"strconv"
+	"time"
+)
+
+// linkTTL is how long link code is valid.
+const linkTTL = 15 * time.Minute
+
+// CreateLink issues one-time code which links Telegram account to the requested user when it is sent
+// to the bot with /start command, user is created if it does not exist. Linked user sees all accounts.
+func CreateLink(ctx context.Context, storage invest.UserStorage, req *pb.TelegramLinkRequest) (*pb.TelegramLinkResponse, error) {
+
+	if req.User == "" {
+		return nil, fmt.Errorf("%w: user is required", invest.ErrInvalidArgument)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	u, err := storage.User(ctx, req.User)
+	if errors.Is(err, invest.ErrNotFound) {
+		u = invest.User{Name: req.User, CreatedAt: now}
+	} else if err != nil {
+		return nil, err
+	}
+
+	code := make([]byte, 8)
+	if _, err := rand.Read(code); err != nil {
+		return nil, fmt.Errorf("generate link code: %w", err)
+	}
+	u.LinkCode = hex.EncodeToString(code)
+	u.LinkExpiresAt = now.Add(linkTTL)
+	if err := storage.SaveUser(ctx, &u); err != nil {
+		return nil, err
+	}
+
+	return &pb.TelegramLinkResponse{
+		Code:      u.LinkCode,
+		ExpiresAt: u.LinkExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// link links Telegram account to user of the code and subscribes chat to notifications of the user.
+// Account previously linked to another user is relinked.
+func (b *Bot) link(ctx context.Context, code string, telegramID, chatID int64) (string, error) {
+
+	u, err := b.storage.UserByLinkCode(ctx, code)
+	if errors.Is(err, invest.ErrNotFound) {
+		return "Link code is invalid.", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if b.now().After(u.LinkExpiresAt) {
+		return "Link code has expired, ask for a new one.", nil
+	}
+
+	previous, err := b.storage.UserByTelegramID(ctx, telegramID)
+	switch {
+	case errors.Is(err, invest.ErrNotFound):
+	case err != nil:
+		return "", err
+	case previous.ID != u.ID:
+		previous.TelegramID = 0
+		if err := b.storage.SaveUser(ctx, &previous); err != nil {
+			return "", err
+		}
+	}
+
+	u.TelegramID = telegramID
+	u.LinkCode = ""
+	u.LinkExpiresAt = time.Time{}
+	if err := b.storage.SaveUser(ctx, &u); err != nil {
+		return "", err
+	}
+
+	if err := b.subscribe(ctx, u, chatID); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Linked to user <b>%s</b>. Alerts and statements will be sent to this chat.\n\n%s",
+		escape(u.Name), help), nil
+}
+
+// subscribe creates Telegram notification channel of user for the chat unless it exists.
+func (b *Bot) subscribe(ctx context.Context, u invest.User, chatID int64) error {
+
+	target := strconv.FormatInt(chatID, 10)
+	channels, err := b.storage.NotificationChannels(ctx, u.Name)
+	if err != nil {
+		return err
+	}
+	for _, c := range channels {
+		if c.Type == notify.ChannelTelegram && c.Target == target {
+			return nil
+		}
+	}
+
+	return b.storage.SaveNotificationChannel(ctx, &invest.NotificationChannel{
+		User:      u.Name,
+		Type:      notify.ChannelTelegram,
+		Target:    target,
+		Enabled:   true,
+		CreatedAt: b.now().UTC().Truncate(time.Second),
+	})
+}
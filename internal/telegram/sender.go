@@ -0,0 +1,42 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goinvest/internal/invest"
+	"goinvest/internal/notify"
+	"time"
+)
+
+// sender delivers notifications to telegram channels with the bot, channel target is a chat id or @channel name.
+type sender struct {
+	client  *client
+	timeout time.Duration
+}
+
+// Sender returns notification sender which posts messages as the bot, a single message is bounded by timeout.
+func (b *Bot) Sender(timeout time.Duration) notify.Sender {
+	return &sender{client: b.client, timeout: timeout}
+}
+
+func (s *sender) Send(ctx context.Context, channel invest.NotificationChannel, notification invest.Notification) error {
+
+	text := escape(notification.Text)
+	if notification.Subject != "" {
+		text = "<b>" + escape(notification.Subject) + "</b>\n\n" + text
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	for _, part := range split(text) {
+		if err := s.client.send(ctx, channel.Target, part); err != nil {
+			var rejected *apiError
+			if errors.As(err, &rejected) && notify.PermanentStatus(rejected.status) {
+				return notify.Permanent(fmt.Errorf("send telegram message: %w", err))
+			}
+			return fmt.Errorf("send telegram message: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// TraceCache wraps cache to trace its operations. Redis is the only cache implementation.
+func TraceCache(cache invest.Cache) invest.Cache {
+	return &tracedCache{cache: cache}
+}
+
+type tracedCache struct {
+	cache invest.Cache
+}
+
+func (c *tracedCache) Get(ctx context.Context, key string, ptrValue interface{}) (err error) {
+
+	ctx, span := Start(ctx, "cache get", trace.SpanKindClient, semconv.DBSystemRedis, semconv.DBOperationKey.String("get"))
+	defer func() {
+		span.SetAttributes(attribute.Bool("cache.hit", err == nil))
+		if errors.Is(err, invest.ErrCacheMiss) {
+			span.End()
+			return
+		}
+		End(span, err)
+	}()
+	return c.cache.Get(ctx, key, ptrValue)
+}
+
+func (c *tracedCache) Set(ctx context.Context, key string, ptrValue interface{}, expires time.Duration) (err error) {
+
+	ctx, span := Start(ctx, "cache set", trace.SpanKindClient, semconv.DBSystemRedis, semconv.DBOperationKey.String("set"))
+	defer func() { End(span, err) }()
+	return c.cache.Set(ctx, key, ptrValue, expires)
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultExportTimeout = 10 * time.Second
+
+// NewExporter returns exporter sending spans to the collector endpoint of config with OTLP over HTTP,
+// plain HTTP is used for http endpoints. Exporter should be shut down with tracer provider.
+func NewExporter(conf Config) (*otlptrace.Exporter, error) {
+
+	if conf.Endpoint == "" {
+		return nil, errors.New("tracing endpoint is empty")
+	}
+	endpoint, err := url.Parse(conf.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("problem while parsing tracing endpoint: %w", err)
+	}
+	if endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("tracing endpoint %s is not an http or https url", conf.Endpoint)
+	}
+	timeout := conf.Timeout
+	if timeout == 0 {
+		timeout = defaultExportTimeout
+	}
+
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(endpoint.Host),
+		otlptracehttp.WithURLPath(strings.TrimSuffix(endpoint.Path, "/") + "/v1/traces"),
+		otlptracehttp.WithHeaders(conf.Headers),
+		otlptracehttp.WithTimeout(timeout),
+	}
+	if endpoint.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	// http client connects on export, so starting it does not block
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("problem while creating tracing exporter: %w", err)
+	}
+	return exporter, nil
+}
@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GraphQL is gqlgen extension which traces operations and fields with resolvers.
+type GraphQL struct{}
+
+var (
+	_ graphql.HandlerExtension    = GraphQL{}
+	_ graphql.ResponseInterceptor = GraphQL{}
+	_ graphql.FieldInterceptor    = GraphQL{}
+)
+
+func (GraphQL) ExtensionName() string {
+	return "Tracing"
+}
+
+func (GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	oc := graphql.GetOperationContext(ctx)
+	var kind, name string
+	if oc.Operation != nil {
+		kind, name = string(oc.Operation.Operation), oc.Operation.Name
+	}
+	if name == "" {
+		name = oc.OperationName
+	}
+	ctx, span := Start(ctx, "graphql "+kind+" "+name, trace.SpanKindServer,
+		attribute.String("graphql.operation.type", kind),
+		attribute.String("graphql.operation.name", name),
+	)
+
+	resp := next(ctx)
+	var err error
+	if resp != nil && len(resp.Errors) > 0 {
+		err = errors.New(resp.Errors.Error())
+	}
+	End(span, err)
+	return resp
+}
+
+func (GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	ctx, span := Start(ctx, fc.Object+"."+fc.Field.Name, trace.SpanKindInternal,
+		attribute.String("graphql.field.path", fc.Path().String()),
+	)
+	res, err := next(ctx)
+	End(span, err)
+	return res, err
+}
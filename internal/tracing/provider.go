@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+)
+
+// TraceProvider wraps provider to trace its calls.
+func TraceProvider(name string, provider invest.Provider) invest.Provider {
+	return invest.WrapProvider(provider, &tracedProvider{name: name, provider: provider})
+}
+
+type tracedProvider struct {
+	name     string
+	provider invest.Provider
+}
+
+// Unwrap returns the traced provider.
+func (p *tracedProvider) Unwrap() invest.Provider {
+	return p.provider
+}
+
+func (p *tracedProvider) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return Start(ctx, "provider "+p.name+" "+method, trace.SpanKindClient,
+		attribute.String("provider.name", p.name),
+		attribute.String("provider.method", method),
+	)
+}
+
+func (p *tracedProvider) Portfolio(ctx context.Context, req *pb.PortfolioRequest) (resp *pb.PortfolioResponse, err error) {
+	ctx, span := p.start(ctx, "Portfolio")
+	defer func() { End(span, err) }()
+	return p.provider.Portfolio(ctx, req)
+}
+
+func (p *tracedProvider) Accounts(ctx context.Context, req *pb.AccountsRequest) (resp *pb.AccountsResponse, err error) {
+	ctx, span := p.start(ctx, "Accounts")
+	defer func() { End(span, err) }()
+	return p.provider.Accounts(ctx, req)
+}
+
+func (p *tracedProvider) Operations(ctx context.Context, req *pb.OperationsRequest) (resp *pb.OperationsResponse, err error) {
+	ctx, span := p.start(ctx, "Operations")
+	defer func() { End(span, err) }()
+	return p.provider.Operations(ctx, req)
+}
+
+func (p *tracedProvider) Quote(ctx context.Context, req *pb.QuoteRequest) (resp *pb.QuoteResponse, err error) {
+	ctx, span := p.start(ctx, "Quote")
+	defer func() { End(span, err) }()
+	return p.provider.Quote(ctx, req)
+}
+
+func (p *tracedProvider) Import(ctx context.Context, req *pb.ImportStatementRequest) (resp *pb.ImportStatementResponse, err error) {
+	ctx, span := p.start(ctx, "Import")
+	defer func() { End(span, err) }()
+	return p.provider.(invest.Importer).Import(ctx, req)
+}
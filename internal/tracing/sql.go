@@ -0,0 +1,199 @@
+package tracing
+
+import (
+	"context"
+	"database/sql/driver"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// TraceConnector wraps database connector to trace queries, statements and transactions.
+func TraceConnector(connector driver.Connector, system string) driver.Connector {
+	return &tracedConnector{connector: connector, system: system}
+}
+
+type tracedConnector struct {
+	connector driver.Connector
+	system    string
+}
+
+func (c *tracedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedConn{Conn: conn, system: c.system}, nil
+}
+
+func (c *tracedConnector) Driver() driver.Driver {
+	return c.connector.Driver()
+}
+
+// traceQuery records operation which started at start. Operations skipped by driver are not recorded,
+// database/sql retries them in another way, e.g. query with arguments as prepared statement.
+func traceQuery(ctx context.Context, system, operation, query string, start time.Time, err error) {
+
+	if err == driver.ErrSkip {
+		return
+	}
+	_, span := otel.Tracer(InstrumentationName).Start(ctx, system+" "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(semconv.DBSystemKey.String(system), semconv.DBOperationKey.String(operation)),
+	)
+	if query != "" {
+		span.SetAttributes(semconv.DBStatementKey.String(query))
+	}
+	End(span, err)
+}
+
+type tracedConn struct {
+	driver.Conn
+	system string
+}
+
+var (
+	_ driver.ConnPrepareContext = &tracedConn{}
+	_ driver.ConnBeginTx        = &tracedConn{}
+	_ driver.ExecerContext      = &tracedConn{}
+	_ driver.QueryerContext     = &tracedConn{}
+	_ driver.Pinger             = &tracedConn{}
+	_ driver.SessionResetter    = &tracedConn{}
+	_ driver.Validator          = &tracedConn{}
+	_ driver.NamedValueChecker  = &tracedConn{}
+)
+
+func (c *tracedConn) PrepareContext(ctx context.Context, query string) (stmt driver.Stmt, err error) {
+
+	defer func(start time.Time) { traceQuery(ctx, c.system, "prepare", query, start, err) }(time.Now())
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tracedStmt{Stmt: stmt, system: c.system, query: query}, nil
+}
+
+func (c *tracedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (tx driver.Tx, err error) {
+
+	defer func(start time.Time) { traceQuery(ctx, c.system, "begin", "", start, err) }(time.Now())
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Conn.Begin()
+	}
+	if err != nil {
+		return nil, err
+	}
+	// driver transactions have no context, commit and rollback are children of transaction start
+	return &tracedTx{Tx: tx, ctx: ctx, system: c.system}, nil
+}
+
+func (c *tracedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (res driver.Result, err error) {
+	defer func(start time.Time) { traceQuery(ctx, c.system, "exec", query, start, err) }(time.Now())
+	if execer, ok := c.Conn.(driver.ExecerContext); ok {
+		return execer.ExecContext(ctx, query, args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c *tracedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (rows driver.Rows, err error) {
+	defer func(start time.Time) { traceQuery(ctx, c.system, "query", query, start, err) }(time.Now())
+	if queryer, ok := c.Conn.(driver.QueryerContext); ok {
+		return queryer.QueryContext(ctx, query, args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c *tracedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *tracedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *tracedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+func (c *tracedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+type tracedStmt struct {
+	driver.Stmt
+	system string
+	query  string
+}
+
+var (
+	_ driver.StmtExecContext   = &tracedStmt{}
+	_ driver.StmtQueryContext  = &tracedStmt{}
+	_ driver.NamedValueChecker = &tracedStmt{}
+)
+
+func (s *tracedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (res driver.Result, err error) {
+	defer func(start time.Time) { traceQuery(ctx, s.system, "exec", s.query, start, err) }(time.Now())
+	if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+		return execer.ExecContext(ctx, args)
+	}
+	return s.Stmt.Exec(values(args))
+}
+
+func (s *tracedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (rows driver.Rows, err error) {
+	defer func(start time.Time) { traceQuery(ctx, s.system, "query", s.query, start, err) }(time.Now())
+	if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		return queryer.QueryContext(ctx, args)
+	}
+	return s.Stmt.Query(values(args))
+}
+
+func (s *tracedStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// values converts arguments for drivers without context aware statements, they support positional arguments only.
+func values(args []driver.NamedValue) []driver.Value {
+	result := make([]driver.Value, len(args))
+	for i, arg := range args {
+		result[i] = arg.Value
+	}
+	return result
+}
+
+type tracedTx struct {
+	driver.Tx
+	ctx    context.Context
+	system string
+}
+
+func (t *tracedTx) Commit() (err error) {
+	defer func(start time.Time) { traceQuery(t.ctx, t.system, "commit", "", start, err) }(time.Now())
+	return t.Tx.Commit()
+}
+
+func (t *tracedTx) Rollback() (err error) {
+	defer func(start time.Time) { traceQuery(t.ctx, t.system, "rollback", "", start, err) }(time.Now())
+	return t.Tx.Rollback()
+}
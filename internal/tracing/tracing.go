@@ -0,0 +1,112 @@
+// Package tracing traces GraphQL operations, gRPC calls, provider calls and storage operations with OpenTelemetry.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"goinvest/internal/invest"
+	"time"
+)
+
+// InstrumentationName names tracer of the service.
+const InstrumentationName = "goinvest"
+
+// Config configures export of spans to OpenTelemetry collector with OTLP over HTTP.
+type Config struct {
+	Enabled     bool              `yaml:"enabled"`
+	Endpoint    string            `yaml:"endpoint" validate:"required_if=Enabled true,omitempty,url"` // collector URL, e.g. http://localhost:4318
+	Headers     map[string]string `yaml:"headers" secret:"true"`                                      // e.g. authorization of collector
+	ServiceName string            `yaml:"serviceName"`                                                // invest by default
+	SampleRatio float64           `yaml:"sampleRatio" validate:"gte=0,lte=1"`                         // share of sampled traces started by the service, all by default
+	Timeout     time.Duration     `yaml:"timeout" validate:"gte=0"`                                   // of a single export, ten seconds by default
+}
+
+// NewProvider returns tracer provider which samples traces according to config and exports spans with exporter.
+// Provider should be shut down to flush spans.
+func NewProvider(conf Config, exporter sdktrace.SpanExporter) (*sdktrace.TracerProvider, error) {
+
+	if exporter == nil {
+		return nil, errors.New("exporter provided to tracer provider is nil")
+	}
+	if conf.SampleRatio < 0 || conf.SampleRatio > 1 {
+		return nil, fmt.Errorf("sample ratio %v is out of [0, 1] range", conf.SampleRatio)
+	}
+
+	serviceName := conf.ServiceName
+	if serviceName == "" {
+		serviceName = "invest"
+	}
+	ratio := conf.SampleRatio
+	if ratio == 0 {
+		ratio = 1
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("problem while creating tracing resource: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// sampling decision of the caller is respected
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	), nil
+}
+
+// Setup registers provider and W3C trace context propagator globally, spans of the package are created with them.
+func Setup(provider trace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Start starts span which is a child of span from the context.
+func Start(ctx context.Context, name string, kind trace.SpanKind, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(InstrumentationName).Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// End records error of the operation and ends span. Cache misses and records which were not found
+// are expected outcomes rather than failures.
+func End(span trace.Span, err error) {
+
+	if err != nil {
+		span.RecordError(err)
+		if !errors.Is(err, invest.ErrCacheMiss) && !errors.Is(err, invest.ErrNotFound) {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
+
+// Fields returns trace and span IDs of the context as log fields, so log records can be found by trace.
+func Fields(ctx context.Context) []zap.Field {
+
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return []zap.Field{
+		zap.String("trace_id", sc.TraceID().String()),
+		zap.String("span_id", sc.SpanID().String()),
+	}
+}
+
+// Logger returns logger which adds trace and span IDs of the context to log records.
+func Logger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+
+	fields := Fields(ctx)
+	if len(fields) == 0 {
+		return logger
+	}
+	return logger.With(fields...)
+}
@@ -0,0 +1,353 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	pb "goinvest/gen/proto/go/invest/v1"
+	"goinvest/internal/invest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// record registers tracer provider which exports spans to memory and returns function
+// which flushes and returns spans ended so far.
+func record(t *testing.T) func() tracetest.SpanStubs {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider, err := NewProvider(Config{ServiceName: "invest-test"}, exporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Setup(provider)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	return func() tracetest.SpanStubs {
+		if err := provider.ForceFlush(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		spans := exporter.GetSpans()
+		exporter.Reset()
+		return spans
+	}
+}
+
+type testProvider struct {
+	invest.Provider
+	err error
+}
+
+func (p *testProvider) Accounts(ctx context.Context, _ *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil, errors.New("provider is called without span")
+	}
+	return &pb.AccountsResponse{}, p.err
+}
+
+type testCache struct {
+	invest.Cache
+}
+
+func (testCache) Get(context.Context, string, interface{}) error {
+	return invest.ErrCacheMiss
+}
+
+func TestTraceProvider(t *testing.T) {
+
+	spans := record(t)
+	ctx, parent := Start(context.Background(), "request", trace.SpanKindServer)
+
+	provider := &testProvider{}
+	p := TraceProvider("test", provider)
+	if _, err := p.Accounts(ctx, &pb.AccountsRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	provider.err = errors.New("token expired")
+	if _, err := p.Accounts(ctx, &pb.AccountsRequest{}); err == nil {
+		t.Error("Accounts() error is lost")
+	}
+	if err := TraceCache(testCache{}).Get(ctx, "key", nil); !errors.Is(err, invest.ErrCacheMiss) {
+		t.Errorf("Get() error = %v, want miss", err)
+	}
+	parent.End()
+
+	got := spans()
+	if len(got) != 4 {
+		t.Fatalf("got %d spans, want 4", len(got))
+	}
+	for _, s := range got[:3] {
+		if s.Parent.SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("span %s is not a child of request", s.Name)
+		}
+	}
+	if got[0].Name != "provider test Accounts" || got[0].SpanKind != trace.SpanKindClient || got[0].Status.Code != codes.Unset {
+		t.Errorf("successful call span = %s %v %v", got[0].Name, got[0].SpanKind, got[0].Status)
+	}
+	if got[1].Status.Code != codes.Error || got[1].Status.Description != "token expired" {
+		t.Errorf("failed call status = %v", got[1].Status)
+	}
+	if got[2].Name != "cache get" || got[2].Status.Code != codes.Unset {
+		t.Errorf("cache miss span = %s %v, miss is not an error", got[2].Name, got[2].Status)
+	}
+	if got[3].Resource.Set().Len() == 0 {
+		t.Error("spans have no resource")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+
+	spans := record(t)
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+	md := metadata.Pairs("traceparent", "00-"+traceID+"-"+spanID+"-01")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx = grpc_ctxtags.SetInContext(ctx, grpc_ctxtags.NewTags())
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/invest.v1.InvestService/GetPortfolio"}
+	_, err := UnaryServerInterceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if got := grpc_ctxtags.Extract(ctx).Values()["trace_id"]; got != traceID {
+			t.Errorf("trace_id tag = %v", got)
+		}
+		return nil, errors.New("boom")
+	})
+	if err == nil {
+		t.Error("handler error is lost")
+	}
+
+	got := spans()
+	if len(got) != 1 {
+		t.Fatalf("got %d spans, want 1", len(got))
+	}
+	s := got[0]
+	if s.Name != "invest.v1.InvestService/GetPortfolio" || s.SpanKind != trace.SpanKindServer {
+		t.Errorf("span = %s %v", s.Name, s.SpanKind)
+	}
+	if s.SpanContext.TraceID().String() != traceID || s.Parent.SpanID().String() != spanID {
+		t.Errorf("trace of the caller is not continued, span %s parent %s", s.SpanContext.TraceID(), s.Parent.SpanID())
+	}
+	if s.Status.Code != codes.Error {
+		t.Errorf("status = %v", s.Status)
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+
+	spans := record(t)
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		Operation: &ast.OperationDefinition{Operation: ast.Query, Name: "Portfolio"},
+	})
+
+	ext := GraphQL{}
+	resp := ext.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+		fieldCtx := graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Object:     "Query",
+			Field:      graphql.CollectedField{Field: &ast.Field{Name: "portfolio", Alias: "portfolio"}},
+			IsResolver: true,
+		})
+		_, _ = ext.InterceptField(fieldCtx, func(context.Context) (interface{}, error) {
+			return nil, errors.New("provider is unavailable")
+		})
+		return &graphql.Response{}
+	})
+	if resp == nil {
+		t.Fatal("response is lost")
+	}
+
+	got := spans()
+	if len(got) != 2 {
+		t.Fatalf("got %d spans, want 2", len(got))
+	}
+	if got[0].Name != "Query.portfolio" || got[0].Status.Code != codes.Error {
+		t.Errorf("field span = %s %v", got[0].Name, got[0].Status)
+	}
+	if got[1].Name != "graphql query Portfolio" || got[0].Parent.SpanID() != got[1].SpanContext.SpanID() {
+		t.Errorf("operation span = %s", got[1].Name)
+	}
+}
+
+// testConnector is database driver which skips queries with arguments, as MySQL driver does.
+type (
+	testConnector struct{}
+	testConn      struct{ driver.Conn }
+	testStmt      struct{ driver.Stmt }
+	testRows      struct{ driver.Rows }
+)
+
+func (testConnector) Connect(context.Context) (driver.Conn, error) { return testConn{}, nil }
+func (testConnector) Driver() driver.Driver                        { return nil }
+
+func (testConn) QueryContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) > 0 {
+		return nil, driver.ErrSkip
+	}
+	return testRows{}, nil
+}
+func (testConn) PrepareContext(context.Context, string) (driver.Stmt, error) { return testStmt{}, nil }
+func (testConn) Close() error                                                { return nil }
+
+func (testStmt) NumInput() int { return 1 }
+func (testStmt) Close() error  { return nil }
+func (testStmt) QueryContext(context.Context, []driver.NamedValue) (driver.Rows, error) {
+	return testRows{}, nil
+}
+
+func (testRows) Columns() []string         { return []string{"id"} }
+func (testRows) Close() error              { return nil }
+func (testRows) Next([]driver.Value) error { return io.EOF }
+
+func TestTraceConnector(t *testing.T) {
+
+	spans := record(t)
+	db := sql.OpenDB(TraceConnector(testConnector{}, "mysql"))
+	defer db.Close()
+
+	ctx := context.Background()
+	for _, args := range [][]interface{}{nil, {1}} {
+		rows, err := db.QueryContext(ctx, "SELECT id FROM users WHERE id = ?", args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = rows.Close()
+	}
+
+	var names []string
+	for _, s := range spans() {
+		names = append(names, s.Name)
+	}
+	// skipped query with arguments is retried as prepared statement
+	want := []string{"mysql query", "mysql prepare", "mysql query"}
+	if len(names) != len(want) {
+		t.Fatalf("spans = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("spans = %v, want %v", names, want)
+			break
+		}
+	}
+}
+
+func TestExporter(t *testing.T) {
+
+	// syncer exports each span in a separate request
+	var requests []*coltracepb.ExportTraceServiceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/otlp/v1/traces" || r.Header.Get("Authorization") != "Bearer secret" ||
+			r.Header.Get("Content-Type") != "application/x-protobuf" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req := &coltracepb.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, req)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer server.Close()
+
+	exporter, err := NewExporter(Config{Endpoint: server.URL + "/otlp/", Headers: map[string]string{"Authorization": "Bearer secret"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, child := provider.Tracer("test").Start(ctx, "child")
+	child.SetAttributes(attribute.Int("rows", 42))
+	child.SetStatus(codes.Error, "not found")
+	child.End()
+	parent.End()
+
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+	body := requests[0]
+	if len(body.ResourceSpans) != 1 || len(body.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("unexpected request %v", body)
+	}
+	scope := body.ResourceSpans[0].ScopeSpans[0]
+	if scope.Scope.Name != "test" || len(scope.Spans) != 1 {
+		t.Fatalf("unexpected scope spans %v", scope)
+	}
+	s := scope.Spans[0]
+	parentTraceID, parentSpanID := parent.SpanContext().TraceID(), parent.SpanContext().SpanID()
+	if s.Name != "child" || !bytes.Equal(s.TraceId, parentTraceID[:]) || !bytes.Equal(s.ParentSpanId, parentSpanID[:]) {
+		t.Errorf("unexpected span %v", s)
+	}
+	if s.Status.Code != tracepb.Status_STATUS_CODE_ERROR || s.Status.Message != "not found" {
+		t.Errorf("status = %v", s.Status)
+	}
+	if len(s.Attributes) != 1 || s.Attributes[0].Key != "rows" || s.Attributes[0].Value.GetIntValue() != 42 {
+		t.Errorf("attributes = %v", s.Attributes)
+	}
+
+	if err := exporter.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLogger(t *testing.T) {
+
+	_ = record(t)
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+
+	Logger(context.Background(), logger).Info("without span")
+	ctx, span := Start(context.Background(), "request", trace.SpanKindInternal)
+	defer span.End()
+	Logger(ctx, logger).Info("with span")
+
+	entries := logs.All()
+	if len(entries[0].Context) != 0 {
+		t.Errorf("fields without span = %v", entries[0].Context)
+	}
+	if got := entries[1].ContextMap()["trace_id"]; got != span.SpanContext().TraceID().String() {
+		t.Errorf("trace_id = %v", got)
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+
+	if _, err := NewProvider(Config{SampleRatio: 2}, tracetest.NewNoopExporter()); err == nil {
+		t.Error("sample ratio out of range is accepted")
+	}
+	if _, err := NewProvider(Config{}, nil); err == nil {
+		t.Error("nil exporter is accepted")
+	}
+	if _, err := NewExporter(Config{Timeout: time.Second}); err == nil {
+		t.Error("empty endpoint is accepted")
+	}
+	if _, err := NewExporter(Config{Endpoint: "localhost:4318"}); err == nil {
+		t.Error("endpoint without scheme is accepted")
+	}
+}
@@ -0,0 +1,107 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+	contentTypes = xmlHeader + `<Types xmlns="` + contentTypesNamespace + `">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/` + workbookPath + `" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/` + sheetPath + `" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/` + stylesPath + `" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`
+
+	packageRels = xmlHeader + `<Relationships xmlns="` + packageRelsNamespace + `">` +
+		`<Relationship Id="rId1" Type="` + relationshipsNamespace + `/officeDocument" Target="` + workbookPath + `"/>` +
+		`</Relationships>`
+
+	// workbookRels targets are relative to xl directory.
+	workbookRels = xmlHeader + `<Relationships xmlns="` + packageRelsNamespace + `">` +
+		`<Relationship Id="rId1" Type="` + relationshipsNamespace + `/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="` + relationshipsNamespace + `/styles" Target="styles.xml"/>` +
+		`</Relationships>`
+
+	// styles defines default cell style 0 and date time style 1.
+	styles = xmlHeader + `<styleSheet xmlns="` + mainNamespace + `">` +
+		`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+		`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="1"><fill><patternFill patternType="none"/></fill></fills>` +
+		`<borders count="1"><border/></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+		`</styleSheet>`
+
+	dateStyle = 1
+)
+
+// Write writes rows to the single worksheet of workbook named sheet. Float64 and time.Time values are stored
+// as typed cells so spreadsheet formats them according to its own locale, other values are stored as text.
+func Write(w io.Writer, sheet string, rows [][]interface{}) error {
+
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name, content string
+	}{
+		{contentTypesPath, contentTypes},
+		{packageRelsPath, packageRels},
+		{workbookPath, workbookXML(sheet)},
+		{workbookRelsPath, workbookRels},
+		{stylesPath, styles},
+		{sheetPath, worksheetXML(rows)},
+	}
+	for _, file := range files {
+		fw, err := zw.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fw, file.content); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func workbookXML(sheet string) string {
+	if sheet == "" {
+		sheet = "Sheet1"
+	}
+	return xmlHeader + `<workbook xmlns="` + mainNamespace + `" xmlns:r="` + relationshipsNamespace + `">` +
+		`<sheets><sheet name="` + escape(sheet) + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+}
+
+func worksheetXML(rows [][]interface{}) string {
+
+	var buf bytes.Buffer
+	buf.WriteString(xmlHeader + `<worksheet xmlns="` + mainNamespace + `"><sheetData>`)
+	for i, row := range rows {
+		number := i + 1
+		fmt.Fprintf(&buf, `<row r="%d">`, number)
+		for j, value := range row {
+			ref := ColumnName(j) + strconv.Itoa(number)
+			switch v := value.(type) {
+			case float64:
+				fmt.Fprintf(&buf, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(v, 'f', -1, 64))
+			case time.Time:
+				// spreadsheets have no time zones, so wall clock of the date is stored
+				_, offset := v.Zone()
+				serial := (v.Sub(epoch) + time.Duration(offset)*time.Second).Hours() / 24
+				fmt.Fprintf(&buf, `<c r="%s" s="%d"><v>%s</v></c>`, ref, dateStyle, strconv.FormatFloat(serial, 'f', -1, 64))
+			default:
+				fmt.Fprintf(&buf, `<c r="%s" t="inlineStr"><is><t>%s</t></is></c>`, ref, escape(fmt.Sprint(v)))
+			}
+		}
+		buf.WriteString(`</row>`)
+	}
+	buf.WriteString(`</sheetData></worksheet>`)
+	return buf.String()
+}
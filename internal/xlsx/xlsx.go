@@ -0,0 +1,59 @@
+// Package xlsx reads and writes the first worksheet of Office Open XML workbooks, enough for
+// statements import and reports export without a spreadsheet library.
+package xlsx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+// ContentType is the media type of XLSX workbooks.
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// Namespaces and package parts of workbook.
+const (
+	mainNamespace          = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	relationshipsNamespace = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	packageRelsNamespace   = "http://schemas.openxmlformats.org/package/2006/relationships"
+	contentTypesNamespace  = "http://schemas.openxmlformats.org/package/2006/content-types"
+
+	contentTypesPath  = "[Content_Types].xml"
+	packageRelsPath   = "_rels/.rels"
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	stylesPath        = "xl/styles.xml"
+	sharedStringsPath = "xl/sharedStrings.xml"
+	// sheetPath is a conventional path of the first worksheet.
+	sheetPath = "xl/worksheets/sheet1.xml"
+)
+
+// epoch is the zero date of spreadsheet date serial numbers.
+var epoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// ColumnName returns column name of zero based index: A, B, ..., Z, AA, AB and so on.
+func ColumnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+// ColumnIndex returns zero based column index of cell reference such as "AB12".
+func ColumnIndex(ref string) int {
+	column := 0
+	for _, c := range ref {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		column = column*26 + int(c-'A'+1)
+	}
+	return column - 1
+}
+
+func escape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,46 @@
+package xlsx
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestColumn(t *testing.T) {
+
+	for index, name := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := ColumnName(index); got != name {
+			t.Errorf("ColumnName(%d): expected %s, got %s", index, name, got)
+		}
+		if got := ColumnIndex(name + "12"); got != index {
+			t.Errorf("ColumnIndex(%s12): expected %d, got %d", name, index, got)
+		}
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+
+	rows := [][]interface{}{
+		{"ticker", "balance", "date"},
+		{"AT&T <T>", 1.5, time.Date(2021, time.January, 15, 10, 30, 0, 0, time.UTC)},
+		{"SBER", -42.0},
+	}
+	var buf bytes.Buffer
+	if err := Write(&buf, "Positions & cash", rows); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"ticker", "balance", "date"},
+		{"AT&T <T>", "1.5", "44211.4375"},
+		{"SBER", "-42"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
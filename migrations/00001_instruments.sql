@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE instruments
+(
+    figi    VARCHAR(32)  NOT NULL PRIMARY KEY,
+    ticker  VARCHAR(32)  NOT NULL DEFAULT '',
+    isin    VARCHAR(32)  NOT NULL DEFAULT '',
+    name    VARCHAR(255) NOT NULL DEFAULT '',
+    sector  VARCHAR(64)  NOT NULL DEFAULT '',
+    country VARCHAR(64)  NOT NULL DEFAULT '',
+    issuer  VARCHAR(255) NOT NULL DEFAULT ''
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- +goose Down
+DROP TABLE instruments;
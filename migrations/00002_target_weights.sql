@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE target_weights
+(
+    account_id VARCHAR(64)    NOT NULL,
+    figi       VARCHAR(32)    NOT NULL,
+    weight     DECIMAL(10, 8) NOT NULL,
+    PRIMARY KEY (account_id, figi)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- +goose Down
+DROP TABLE target_weights;
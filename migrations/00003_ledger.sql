@@ -0,0 +1,52 @@
+-- +goose Up
+CREATE TABLE ledger_accounts
+(
+    provider_id  INT UNSIGNED NOT NULL,
+    account_id   VARCHAR(64)  NOT NULL,
+    account_type INT          NOT NULL DEFAULT 0,
+    name         VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (provider_id, account_id)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE ledger_positions
+(
+    provider_id     INT UNSIGNED   NOT NULL,
+    account_id      VARCHAR(64)    NOT NULL,
+    position_key    VARCHAR(64)    NOT NULL,
+    figi            VARCHAR(32)    NOT NULL DEFAULT '',
+    ticker          VARCHAR(32)    NOT NULL DEFAULT '',
+    isin            VARCHAR(32)    NOT NULL DEFAULT '',
+    name            VARCHAR(255)   NOT NULL DEFAULT '',
+    instrument_type VARCHAR(32)    NOT NULL DEFAULT '',
+    balance         DECIMAL(24, 8) NOT NULL,
+    average_price   DECIMAL(24, 8) NOT NULL DEFAULT 0,
+    price           DECIMAL(24, 8) NOT NULL DEFAULT 0,
+    currency        VARCHAR(8)     NOT NULL DEFAULT '',
+    PRIMARY KEY (provider_id, account_id, position_key)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE ledger_operations
+(
+    provider_id     INT UNSIGNED   NOT NULL,
+    account_id      VARCHAR(64)    NOT NULL,
+    operation_id    VARCHAR(64)    NOT NULL,
+    operation_type  INT            NOT NULL,
+    figi            VARCHAR(32)    NOT NULL DEFAULT '',
+    instrument_type VARCHAR(32)    NOT NULL DEFAULT '',
+    date            DATETIME       NOT NULL,
+    quantity        INT            NOT NULL DEFAULT 0,
+    price           DECIMAL(24, 8) NOT NULL DEFAULT 0,
+    payment         DECIMAL(24, 8) NOT NULL DEFAULT 0,
+    currency        VARCHAR(8)     NOT NULL DEFAULT '',
+    commission      DECIMAL(24, 8) NOT NULL DEFAULT 0,
+    PRIMARY KEY (provider_id, account_id, operation_id),
+    KEY ledger_operations_date (provider_id, account_id, date)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- +goose Down
+DROP TABLE ledger_operations;
+DROP TABLE ledger_positions;
+DROP TABLE ledger_accounts;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE statements
+(
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    provider     VARCHAR(64)     NOT NULL DEFAULT '',
+    account_id   VARCHAR(64)     NOT NULL,
+    period       VARCHAR(16)     NOT NULL,
+    period_from  DATETIME        NOT NULL,
+    period_to    DATETIME        NOT NULL,
+    format       VARCHAR(8)      NOT NULL,
+    filename     VARCHAR(255)    NOT NULL,
+    content_type VARCHAR(128)    NOT NULL,
+    content      MEDIUMBLOB      NOT NULL,
+    created_at   DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    KEY statements_account (provider, account_id, period_from)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- +goose Down
+DROP TABLE statements;
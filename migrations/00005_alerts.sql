@@ -0,0 +1,52 @@
+-- +goose Up
+CREATE TABLE alert_rules
+(
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    type         INT             NOT NULL,
+    name         VARCHAR(255)    NOT NULL DEFAULT '',
+    provider     VARCHAR(64)     NOT NULL DEFAULT '',
+    account_id   VARCHAR(64)     NOT NULL DEFAULT '',
+    figi         VARCHAR(32)     NOT NULL DEFAULT '',
+    threshold    DECIMAL(24, 8)  NOT NULL,
+    enabled      BOOLEAN         NOT NULL DEFAULT TRUE,
+    created_at   DATETIME        NOT NULL,
+    active       BOOLEAN         NOT NULL DEFAULT FALSE,
+    peak         DECIMAL(24, 8)  NOT NULL DEFAULT 0,
+    triggered_at DATETIME        NULL,
+    PRIMARY KEY (id),
+    KEY alert_rules_account (provider, account_id)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE alert_events
+(
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    rule_id      BIGINT UNSIGNED NOT NULL,
+    type         INT             NOT NULL,
+    provider     VARCHAR(64)     NOT NULL DEFAULT '',
+    account_id   VARCHAR(64)     NOT NULL DEFAULT '',
+    figi         VARCHAR(32)     NOT NULL DEFAULT '',
+    threshold    DECIMAL(24, 8)  NOT NULL,
+    value        DECIMAL(24, 8)  NOT NULL,
+    message      VARCHAR(1024)   NOT NULL,
+    triggered_at DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    KEY alert_events_rule (rule_id, triggered_at),
+    KEY alert_events_account (provider, account_id, triggered_at)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE portfolio_snapshots
+(
+    provider   VARCHAR(64)    NOT NULL DEFAULT '',
+    account_id VARCHAR(64)    NOT NULL,
+    date       DATE           NOT NULL,
+    value      DECIMAL(24, 8) NOT NULL,
+    PRIMARY KEY (provider, account_id, date)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- +goose Down
+DROP TABLE portfolio_snapshots;
+DROP TABLE alert_events;
+DROP TABLE alert_rules;
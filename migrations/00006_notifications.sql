@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE notification_channels
+(
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    username   VARCHAR(64)     NOT NULL,
+    type       VARCHAR(16)     NOT NULL,
+    target     VARCHAR(512)    NOT NULL,
+    secret     VARCHAR(255)    NOT NULL DEFAULT '',
+    kinds      VARCHAR(255)    NOT NULL DEFAULT '',
+    enabled    BOOLEAN         NOT NULL DEFAULT TRUE,
+    created_at DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    KEY notification_channels_username (username)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE notification_dead_letters
+(
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    channel_id   BIGINT UNSIGNED NOT NULL,
+    channel_type VARCHAR(16)     NOT NULL,
+    target       VARCHAR(512)    NOT NULL,
+    kind         VARCHAR(32)     NOT NULL,
+    subject      VARCHAR(255)    NOT NULL,
+    text         TEXT            NOT NULL,
+    error        VARCHAR(1024)   NOT NULL,
+    attempts     INT             NOT NULL,
+    created_at   DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    KEY notification_dead_letters_channel (channel_id, created_at)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- +goose Down
+DROP TABLE notification_dead_letters;
+DROP TABLE notification_channels;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE users
+(
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name            VARCHAR(64)     NOT NULL,
+    telegram_id     BIGINT          NULL,
+    link_code       VARCHAR(32)     NULL,
+    link_expires_at DATETIME        NULL,
+    created_at      DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY users_name (name),
+    UNIQUE KEY users_telegram_id (telegram_id),
+    UNIQUE KEY users_link_code (link_code)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- +goose Down
+DROP TABLE users;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE ledger_operations
+    ADD COLUMN ticker VARCHAR(32) NOT NULL DEFAULT '' AFTER figi,
+    ADD COLUMN isin   VARCHAR(32) NOT NULL DEFAULT '' AFTER ticker;
+
+-- +goose Down
+ALTER TABLE ledger_operations
+    DROP COLUMN isin,
+    DROP COLUMN ticker;
@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE target_weights
+    ADD COLUMN provider VARCHAR(64) NOT NULL DEFAULT '' FIRST,
+    DROP PRIMARY KEY,
+    ADD PRIMARY KEY (provider, account_id, figi);
+
+-- +goose Down
+DELETE FROM target_weights WHERE provider <> '';
+ALTER TABLE target_weights
+    DROP PRIMARY KEY,
+    ADD PRIMARY KEY (account_id, figi),
+    DROP COLUMN provider;
@@ -0,0 +1,11 @@
+// Package migrations embeds goose migrations of the MySQL schema, see mysql.Migrate.
+package migrations
+
+import (
+	"embed"
+)
+
+// FS contains SQL migrations ordered by their version prefix.
+//
+//go:embed *.sql
+var FS embed.FS